@@ -5,6 +5,9 @@ import (
 	databasePkg "main/pkg/database"
 	"main/pkg/fs"
 	"main/pkg/logger"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
 )
@@ -16,6 +19,14 @@ var (
 func ExecuteMain(configPath string) {
 	filesystem := &fs.OsFS{}
 	app := pkg.NewApp(configPath, filesystem, version)
+
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+		<-signals
+		app.Stop()
+	}()
+
 	app.Start()
 }
 
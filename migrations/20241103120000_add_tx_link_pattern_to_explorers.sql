@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE explorers ADD COLUMN tx_link_pattern TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE explorers DROP COLUMN tx_link_pattern;
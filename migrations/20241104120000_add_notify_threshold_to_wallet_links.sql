@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE wallet_links ADD COLUMN notify_threshold DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE wallet_links DROP COLUMN notify_threshold;
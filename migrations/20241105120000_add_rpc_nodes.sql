@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE rpc_nodes (
+     chain TEXT NOT NULL REFERENCES chains(name),
+     host TEXT NOT NULL,
+     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+     PRIMARY KEY (chain, host)
+);
+
+-- +goose Down
+DROP TABLE rpc_nodes;
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE upgrade_notifications (
+     chain TEXT NOT NULL REFERENCES chains(name),
+     name TEXT NOT NULL,
+     threshold TEXT NOT NULL,
+     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+     PRIMARY KEY (chain, name, threshold)
+);
+
+-- +goose Down
+DROP TABLE upgrade_notifications;
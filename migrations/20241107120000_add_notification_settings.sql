@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE notification_settings (
+    reporter TEXT NOT NULL,
+    chat_id TEXT NOT NULL,
+    muted_types TEXT[] NOT NULL DEFAULT '{}',
+    muted_chains TEXT[] NOT NULL DEFAULT '{}',
+    mode TEXT NOT NULL DEFAULT 'instant',
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    quiet_hours_start INTEGER,
+    quiet_hours_end INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (reporter, chat_id)
+);
+
+CREATE TABLE notifications_queue (
+    id SERIAL PRIMARY KEY,
+    reporter TEXT NOT NULL,
+    chat_id TEXT NOT NULL,
+    type TEXT NOT NULL,
+    chain TEXT NOT NULL,
+    text TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX notifications_queue_chat ON notifications_queue (reporter, chat_id);
+
+-- +goose Down
+DROP TABLE notifications_queue;
+DROP TABLE notification_settings;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE digest_subscriptions (
+    reporter TEXT NOT NULL,
+    chat_id TEXT NOT NULL,
+    frequency TEXT NOT NULL,
+    time INTEGER NOT NULL,
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    balances_user_id TEXT,
+    last_sent_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    snapshot JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (reporter, chat_id)
+);
+
+-- +goose Down
+DROP TABLE digest_subscriptions;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE price_alerts (
+    id SERIAL PRIMARY KEY,
+    reporter TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    chain TEXT NOT NULL REFERENCES chains(name),
+    denom TEXT NOT NULL,
+    direction TEXT NOT NULL,
+    target DOUBLE PRECISION NOT NULL,
+    triggered_at TIMESTAMPTZ,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX price_alerts_user ON price_alerts (reporter, user_id);
+
+-- +goose Down
+DROP TABLE price_alerts;
@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE whale_alerts (
+    reporter TEXT NOT NULL,
+    chat_id TEXT NOT NULL,
+    chain TEXT NOT NULL REFERENCES chains(name),
+    percent_threshold DOUBLE PRECISION,
+    tokens_threshold DOUBLE PRECISION,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (reporter, chat_id, chain)
+);
+
+CREATE TABLE validators_snapshots (
+    chain TEXT NOT NULL PRIMARY KEY REFERENCES chains(name),
+    tokens JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE validators_snapshots;
+DROP TABLE whale_alerts;
@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE active_set_warnings (
+    chain TEXT NOT NULL REFERENCES chains(name),
+    address TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (chain, address)
+);
+
+-- +goose Down
+DROP TABLE active_set_warnings;
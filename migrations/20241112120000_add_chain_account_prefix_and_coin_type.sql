@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE chains ADD COLUMN bech32_account_prefix TEXT;
+-- Only chains following the <prefix>valoper convention can have it derived,
+-- others have it empty, so it has to be set via /chain_update.
+UPDATE chains SET bech32_account_prefix = '';
+UPDATE chains SET bech32_account_prefix = regexp_replace(bech32_validator_prefix, 'valoper$', '')
+    WHERE bech32_validator_prefix LIKE '%valoper';
+ALTER TABLE chains ALTER COLUMN bech32_account_prefix SET NOT NULL;
+ALTER TABLE chains ADD COLUMN coin_type INTEGER NOT NULL DEFAULT 118;
+
+-- +goose Down
+ALTER TABLE chains DROP COLUMN coin_type;
+ALTER TABLE chains DROP COLUMN bech32_account_prefix;
@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE TABLE wallets_watcher_heights (
+    chain TEXT NOT NULL PRIMARY KEY REFERENCES chains(name),
+    height BIGINT NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE wallets_watcher_heights;
@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE chains ADD COLUMN mint_module TEXT NOT NULL DEFAULT 'standard';
+UPDATE chains SET mint_module = name WHERE name IN ('celestia', 'osmosis');
+
+-- +goose Down
+ALTER TABLE chains DROP COLUMN mint_module;
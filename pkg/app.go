@@ -1,6 +1,7 @@
 package pkg
 
 import (
+	"context"
 	converterPkg "main/pkg/converter"
 	datafetcher "main/pkg/data_fetcher"
 	databasePkg "main/pkg/database"
@@ -9,9 +10,13 @@ import (
 	"main/pkg/interacter/telegram"
 	"main/pkg/logger"
 	"main/pkg/metrics"
+	notifierPkg "main/pkg/notifier"
+	"main/pkg/scheduler"
 	"main/pkg/tendermint"
 	timePkg "main/pkg/time"
 	"main/pkg/types"
+	"main/pkg/watcher"
+	"sync"
 
 	"github.com/rs/zerolog"
 )
@@ -21,10 +26,20 @@ type App struct {
 	Config  *types.Config
 	Version string
 
-	Interacters    []interacterPkg.Interacter
-	MetricsManager *metrics.Manager
-	Database       *databasePkg.Database
-	Converter      *converterPkg.Converter
+	Interacters     []interacterPkg.Interacter
+	MetricsManager  *metrics.Manager
+	Database        *databasePkg.Database
+	Converter       *converterPkg.Converter
+	WalletsWatcher  *watcher.WalletsWatcher
+	BlocksWatcher   *watcher.BlocksWatcher
+	UpgradesWatcher *watcher.UpgradesWatcher
+	Scheduler       *scheduler.Scheduler
+
+	DecentralizationWatcher *watcher.DecentralizationWatcher
+	DigestsWatcher          *watcher.DigestsWatcher
+	PriceAlertsWatcher      *watcher.PriceAlertsWatcher
+	WhaleAlertsWatcher      *watcher.WhaleAlertsWatcher
+	ActiveSetWatcher        *watcher.ActiveSetWatcher
 
 	StopChannel chan bool
 }
@@ -49,20 +64,69 @@ func NewApp(configPath string, filesystem fs.FS, version string) *App {
 	converter := converterPkg.NewConverter()
 	database := databasePkg.NewDatabase(log, config.DatabaseConfig)
 	metricsManager := metrics.NewManager(log, config.MetricsConfig)
-	nodesManager := tendermint.NewNodeManager(log, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(log, config.PaginationConfig, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(log, database, converter, metricsManager, nodesManager)
-	interacters := []interacterPkg.Interacter{
-		telegram.NewInteracter(config.TelegramConfig, version, log, dataFetcher, database, metricsManager, &timePkg.SystemTime{}),
+	timer := &timePkg.SystemTime{}
+	jobsScheduler := scheduler.NewScheduler(log, database, metricsManager, timer)
+	telegramInteracter := telegram.NewInteracter(config.TelegramConfig, version, log, dataFetcher, database, metricsManager, timer)
+	telegramInteracter.Scheduler = jobsScheduler
+	interacters := []interacterPkg.Interacter{telegramInteracter}
+	notifier := notifierPkg.NewNotifier(config.NotifierConfig, log, database, metricsManager, interacters, timer)
+	walletsWatcher := watcher.NewWalletsWatcher(config.WatcherConfig, log, database, dataFetcher, nodesManager, notifier)
+	blocksWatcher := watcher.NewBlocksWatcher(config.UptimeConfig, log, database, dataFetcher, nodesManager)
+	upgradesWatcher := watcher.NewUpgradesWatcher(config.UpgradesConfig, log, database, dataFetcher, notifier, timer)
+	decentralizationWatcher := watcher.NewDecentralizationWatcher(config.DecentralizationConfig, log, database, dataFetcher)
+	digestsWatcher := watcher.NewDigestsWatcher(config.DigestsConfig, log, database, dataFetcher, notifier, timer)
+	priceAlertsWatcher := watcher.NewPriceAlertsWatcher(config.PriceAlertsConfig, log, database, dataFetcher, notifier, timer)
+	whaleAlertsWatcher := watcher.NewWhaleAlertsWatcher(config.WhaleAlertsConfig, log, database, dataFetcher, notifier, timer)
+	activeSetWatcher := watcher.NewActiveSetWatcher(config.ActiveSetConfig, log, database, dataFetcher, notifier)
+
+	// Jobs sending notifications only run on one replica at a time,
+	// while the watchers keeping the local state run on each of them.
+	if walletsWatcher.Enabled() {
+		jobsScheduler.Register("wallets", config.WatcherConfig.Interval, walletsWatcher.Tick)
+	}
+
+	if upgradesWatcher.Enabled() {
+		jobsScheduler.Register("upgrades", config.UpgradesConfig.Interval, upgradesWatcher.Tick)
+	}
+
+	if digestsWatcher.Enabled() {
+		jobsScheduler.Register("digests", config.DigestsConfig.Interval, digestsWatcher.Tick)
+	}
+
+	if priceAlertsWatcher.Enabled() {
+		jobsScheduler.Register("price_alerts", config.PriceAlertsConfig.Interval, priceAlertsWatcher.Tick)
 	}
 
+	if whaleAlertsWatcher.Enabled() {
+		jobsScheduler.Register("whale_alerts", config.WhaleAlertsConfig.Interval, whaleAlertsWatcher.Tick)
+	}
+
+	if activeSetWatcher.Enabled() {
+		jobsScheduler.Register("active_set", config.ActiveSetConfig.Interval, activeSetWatcher.Tick)
+	}
+
+	jobsScheduler.Register("notifications", config.NotifierConfig.FlushInterval, notifier.Flush)
+
 	return &App{
-		Logger:         log,
-		Config:         config,
-		Version:        version,
-		Interacters:    interacters,
-		Database:       database,
-		MetricsManager: metricsManager,
-		StopChannel:    make(chan bool),
+		Logger:          log,
+		Config:          config,
+		Version:         version,
+		Interacters:     interacters,
+		Database:        database,
+		MetricsManager:  metricsManager,
+		WalletsWatcher:  walletsWatcher,
+		BlocksWatcher:   blocksWatcher,
+		UpgradesWatcher: upgradesWatcher,
+		Scheduler:       jobsScheduler,
+		StopChannel:     make(chan bool),
+
+		DecentralizationWatcher: decentralizationWatcher,
+		DigestsWatcher:          digestsWatcher,
+		PriceAlertsWatcher:      priceAlertsWatcher,
+		WhaleAlertsWatcher:      whaleAlertsWatcher,
+		ActiveSetWatcher:        activeSetWatcher,
 	}
 }
 
@@ -87,10 +151,122 @@ func (a *App) Start() {
 		}
 	}
 
+	if a.WalletsWatcher.Enabled() {
+		a.Logger.Info().Msg("Wallets watcher is enabled")
+	} else {
+		a.Logger.Info().Msg("Wallets watcher is disabled")
+	}
+
+	if a.UpgradesWatcher.Enabled() {
+		a.Logger.Info().Msg("Upgrades watcher is enabled")
+	} else {
+		a.Logger.Info().Msg("Upgrades watcher is disabled")
+	}
+
+	if a.DigestsWatcher.Enabled() {
+		a.Logger.Info().Msg("Digests watcher is enabled")
+	} else {
+		a.Logger.Info().Msg("Digests watcher is disabled")
+	}
+
+	if a.PriceAlertsWatcher.Enabled() {
+		a.Logger.Info().Msg("Price alerts watcher is enabled")
+	} else {
+		a.Logger.Info().Msg("Price alerts watcher is disabled")
+	}
+
+	if a.WhaleAlertsWatcher.Enabled() {
+		a.Logger.Info().Msg("Whale alerts watcher is enabled")
+	} else {
+		a.Logger.Info().Msg("Whale alerts watcher is disabled")
+	}
+
+	if a.ActiveSetWatcher.Enabled() {
+		a.Logger.Info().Msg("Active set watcher is enabled")
+	} else {
+		a.Logger.Info().Msg("Active set watcher is disabled")
+	}
+
+	if a.Scheduler.Enabled() {
+		go a.Scheduler.Start()
+	}
+
+	if a.BlocksWatcher.Enabled() {
+		a.Logger.Info().Msg("Blocks watcher is enabled")
+		go a.BlocksWatcher.Start()
+	} else {
+		a.Logger.Info().Msg("Blocks watcher is disabled")
+	}
+
+	if a.DecentralizationWatcher.Enabled() {
+		a.Logger.Info().Msg("Decentralization watcher is enabled")
+		go a.DecentralizationWatcher.Start()
+	} else {
+		a.Logger.Info().Msg("Decentralization watcher is disabled")
+	}
+
 	<-a.StopChannel
-	a.Logger.Info().Msg("Shutting down...")
+	a.Logger.Info().Msg("Shut down")
 }
 
+// Stop stops accepting new commands and gives the commands being processed
+// and the watchers' ongoing ticks the configured grace period to finish,
+// then stops the metrics server and closes the database.
 func (a *App) Stop() {
+	a.Logger.Info().
+		Dur("grace_period", a.Config.ShutdownConfig.GracePeriod).
+		Msg("Shutting down...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.Config.ShutdownConfig.GracePeriod)
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	stoppers := make([]func(), 0)
+
+	for _, interacter := range a.Interacters {
+		if interacter.Enabled() {
+			stoppers = append(stoppers, interacter.Stop)
+		}
+	}
+
+	if a.Scheduler.Enabled() {
+		stoppers = append(stoppers, a.Scheduler.Stop)
+	}
+
+	if a.BlocksWatcher.Enabled() {
+		stoppers = append(stoppers, a.BlocksWatcher.Stop)
+	}
+
+	if a.DecentralizationWatcher.Enabled() {
+		stoppers = append(stoppers, a.DecentralizationWatcher.Stop)
+	}
+
+	wg.Add(len(stoppers))
+
+	for _, stop := range stoppers {
+		go func(stop func()) {
+			defer wg.Done()
+			stop()
+		}(stop)
+	}
+
+	done := make(chan bool)
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		a.Logger.Info().Msg("All interacters and watchers are stopped")
+	case <-ctx.Done():
+		a.Logger.Warn().Msg("Grace period is over, not waiting for interacters and watchers anymore")
+	}
+
+	a.MetricsManager.Stop(ctx)
+	a.Database.Close()
+
 	a.StopChannel <- true
 }
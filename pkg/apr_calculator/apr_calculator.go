@@ -0,0 +1,86 @@
+package aprcalculator
+
+import (
+	"errors"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"cosmossdk.io/math"
+)
+
+type APRCalculator interface {
+	GetAPR(chain *types.Chain) (float64, error)
+	Name() string
+}
+
+// CalculateAPR returns the staking APR given the amount of tokens minted per year:
+// they are distributed among the bonded tokens, except for the community tax part.
+func CalculateAPR(
+	annualProvisions math.LegacyDec,
+	bondedTokens math.LegacyDec,
+	communityTax math.LegacyDec,
+) (float64, error) {
+	if !bondedTokens.IsPositive() {
+		return 0, errors.New("chain has no bonded tokens")
+	}
+
+	return annualProvisions.
+		Quo(bondedTokens).
+		Mul(math.LegacyOneDec().Sub(communityTax)).
+		Float64()
+}
+
+// GetStakingInfo returns bonded tokens amount and community tax,
+// which are needed for APR calculation on every chain.
+func GetStakingInfo(
+	nodesManager *tendermint.NodeManager,
+	chain *types.Chain,
+) (math.LegacyDec, math.LegacyDec, error) {
+	var wg sync.WaitGroup
+
+	var (
+		bondedTokens    math.LegacyDec
+		bondedTokensErr error
+		communityTax    math.LegacyDec
+		communityTaxErr error
+	)
+
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+
+		pool, err := nodesManager.GetPool(chain)
+		if err != nil {
+			bondedTokensErr = err
+			return
+		}
+
+		bondedTokens = pool.Pool.BondedTokens.ToLegacyDec()
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		params, err := nodesManager.GetDistributionParams(chain)
+		if err != nil {
+			communityTaxErr = err
+			return
+		}
+
+		communityTax = params.Params.CommunityTax
+	}()
+
+	wg.Wait()
+
+	if bondedTokensErr != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, bondedTokensErr
+	}
+
+	if communityTaxErr != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, communityTaxErr
+	}
+
+	return bondedTokens, communityTax, nil
+}
@@ -0,0 +1,100 @@
+package aprcalculator
+
+import (
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"cosmossdk.io/math"
+	"github.com/rs/zerolog"
+)
+
+type CelestiaInflationRateResponse struct {
+	InflationRate string `json:"inflation_rate"`
+}
+
+// CelestiaAPRCalculator calculates APR for Celestia, which has its own mint module
+// with the inflation rate served at a custom endpoint.
+type CelestiaAPRCalculator struct {
+	Logger       zerolog.Logger
+	NodesManager *tendermint.NodeManager
+}
+
+func NewCelestiaAPRCalculator(
+	logger *zerolog.Logger,
+	nodesManager *tendermint.NodeManager,
+) *CelestiaAPRCalculator {
+	return &CelestiaAPRCalculator{
+		Logger:       logger.With().Str("component", "celestia_apr_calculator").Logger(),
+		NodesManager: nodesManager,
+	}
+}
+
+func (c *CelestiaAPRCalculator) GetAPR(chain *types.Chain) (float64, error) {
+	var wg sync.WaitGroup
+
+	var (
+		inflation      math.LegacyDec
+		inflationErr   error
+		supply         math.LegacyDec
+		supplyErr      error
+		bondedTokens   math.LegacyDec
+		communityTax   math.LegacyDec
+		stakingInfoErr error
+	)
+
+	wg.Add(3)
+
+	go func() {
+		defer wg.Done()
+
+		var response CelestiaInflationRateResponse
+		if err := c.NodesManager.GetJSON(chain, "/celestia/mint/v1/inflation_rate", "celestia_inflation_rate", &response); err != nil {
+			inflationErr = err
+			return
+		}
+
+		inflation, inflationErr = math.LegacyNewDecFromStr(response.InflationRate)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		response, err := c.NodesManager.GetSupplyOf(chain, chain.BaseDenom)
+		if err != nil {
+			supplyErr = err
+			return
+		}
+
+		supply = response.Amount.Amount.ToLegacyDec()
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		bondedTokens, communityTax, stakingInfoErr = GetStakingInfo(c.NodesManager, chain)
+	}()
+
+	wg.Wait()
+
+	if inflationErr != nil {
+		c.Logger.Warn().Err(inflationErr).Str("chain", chain.Name).Msg("Could not fetch inflation rate")
+		return 0, inflationErr
+	}
+
+	if supplyErr != nil {
+		c.Logger.Warn().Err(supplyErr).Str("chain", chain.Name).Msg("Could not fetch supply")
+		return 0, supplyErr
+	}
+
+	if stakingInfoErr != nil {
+		c.Logger.Warn().Err(stakingInfoErr).Str("chain", chain.Name).Msg("Could not fetch staking info")
+		return 0, stakingInfoErr
+	}
+
+	return CalculateAPR(inflation.Mul(supply), bondedTokens, communityTax)
+}
+
+func (c *CelestiaAPRCalculator) Name() string {
+	return "celestia"
+}
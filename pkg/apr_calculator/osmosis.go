@@ -0,0 +1,140 @@
+package aprcalculator
+
+import (
+	"fmt"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"cosmossdk.io/math"
+	"github.com/rs/zerolog"
+)
+
+type OsmosisEpochProvisionsResponse struct {
+	EpochProvisions string `json:"epoch_provisions"`
+}
+
+type OsmosisMintParamsResponse struct {
+	Params struct {
+		EpochIdentifier         string `json:"epoch_identifier"`
+		DistributionProportions struct {
+			Staking string `json:"staking"`
+		} `json:"distribution_proportions"`
+	} `json:"params"`
+}
+
+// OsmosisAPRCalculator calculates APR for Osmosis, which mints tokens every epoch
+// instead of per block and only sends a part of them to stakers.
+type OsmosisAPRCalculator struct {
+	Logger       zerolog.Logger
+	NodesManager *tendermint.NodeManager
+}
+
+func NewOsmosisAPRCalculator(
+	logger *zerolog.Logger,
+	nodesManager *tendermint.NodeManager,
+) *OsmosisAPRCalculator {
+	return &OsmosisAPRCalculator{
+		Logger:       logger.With().Str("component", "osmosis_apr_calculator").Logger(),
+		NodesManager: nodesManager,
+	}
+}
+
+func (c *OsmosisAPRCalculator) GetEpochsPerYear(epochIdentifier string) (int64, error) {
+	switch epochIdentifier {
+	case "hour":
+		return 365 * 24, nil
+	case "day":
+		return 365, nil
+	case "week":
+		return 52, nil
+	default:
+		return 0, fmt.Errorf("unsupported epoch identifier: %s", epochIdentifier)
+	}
+}
+
+func (c *OsmosisAPRCalculator) GetAPR(chain *types.Chain) (float64, error) {
+	var wg sync.WaitGroup
+
+	var (
+		epochProvisionsResponse OsmosisEpochProvisionsResponse
+		epochProvisionsErr      error
+		paramsResponse          OsmosisMintParamsResponse
+		paramsErr               error
+		bondedTokens            math.LegacyDec
+		communityTax            math.LegacyDec
+		stakingInfoErr          error
+	)
+
+	wg.Add(3)
+
+	go func() {
+		defer wg.Done()
+
+		epochProvisionsErr = c.NodesManager.GetJSON(
+			chain,
+			"/osmosis/mint/v1beta1/epoch_provisions",
+			"osmosis_epoch_provisions",
+			&epochProvisionsResponse,
+		)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		paramsErr = c.NodesManager.GetJSON(
+			chain,
+			"/osmosis/mint/v1beta1/params",
+			"osmosis_mint_params",
+			&paramsResponse,
+		)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		bondedTokens, communityTax, stakingInfoErr = GetStakingInfo(c.NodesManager, chain)
+	}()
+
+	wg.Wait()
+
+	if epochProvisionsErr != nil {
+		c.Logger.Warn().Err(epochProvisionsErr).Str("chain", chain.Name).Msg("Could not fetch epoch provisions")
+		return 0, epochProvisionsErr
+	}
+
+	if paramsErr != nil {
+		c.Logger.Warn().Err(paramsErr).Str("chain", chain.Name).Msg("Could not fetch mint params")
+		return 0, paramsErr
+	}
+
+	if stakingInfoErr != nil {
+		c.Logger.Warn().Err(stakingInfoErr).Str("chain", chain.Name).Msg("Could not fetch staking info")
+		return 0, stakingInfoErr
+	}
+
+	epochProvisions, err := math.LegacyNewDecFromStr(epochProvisionsResponse.EpochProvisions)
+	if err != nil {
+		return 0, err
+	}
+
+	stakingProportion, err := math.LegacyNewDecFromStr(paramsResponse.Params.DistributionProportions.Staking)
+	if err != nil {
+		return 0, err
+	}
+
+	epochsPerYear, err := c.GetEpochsPerYear(paramsResponse.Params.EpochIdentifier)
+	if err != nil {
+		return 0, err
+	}
+
+	annualProvisions := epochProvisions.
+		MulInt64(epochsPerYear).
+		Mul(stakingProportion)
+
+	return CalculateAPR(annualProvisions, bondedTokens, communityTax)
+}
+
+func (c *OsmosisAPRCalculator) Name() string {
+	return "osmosis"
+}
@@ -0,0 +1,96 @@
+package aprcalculator
+
+import (
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"cosmossdk.io/math"
+	"github.com/rs/zerolog"
+)
+
+// StandardAPRCalculator calculates APR for chains using the cosmos-sdk x/mint module,
+// as inflation * total supply / bonded tokens * (1 - community tax).
+type StandardAPRCalculator struct {
+	Logger       zerolog.Logger
+	NodesManager *tendermint.NodeManager
+}
+
+func NewStandardAPRCalculator(
+	logger *zerolog.Logger,
+	nodesManager *tendermint.NodeManager,
+) *StandardAPRCalculator {
+	return &StandardAPRCalculator{
+		Logger:       logger.With().Str("component", "standard_apr_calculator").Logger(),
+		NodesManager: nodesManager,
+	}
+}
+
+func (c *StandardAPRCalculator) GetAPR(chain *types.Chain) (float64, error) {
+	var wg sync.WaitGroup
+
+	var (
+		inflation      math.LegacyDec
+		inflationErr   error
+		supply         math.LegacyDec
+		supplyErr      error
+		bondedTokens   math.LegacyDec
+		communityTax   math.LegacyDec
+		stakingInfoErr error
+	)
+
+	wg.Add(3)
+
+	go func() {
+		defer wg.Done()
+
+		response, err := c.NodesManager.GetInflation(chain)
+		if err != nil {
+			inflationErr = err
+			return
+		}
+
+		inflation = response.Inflation
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		response, err := c.NodesManager.GetSupplyOf(chain, chain.BaseDenom)
+		if err != nil {
+			supplyErr = err
+			return
+		}
+
+		supply = response.Amount.Amount.ToLegacyDec()
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		bondedTokens, communityTax, stakingInfoErr = GetStakingInfo(c.NodesManager, chain)
+	}()
+
+	wg.Wait()
+
+	if inflationErr != nil {
+		c.Logger.Warn().Err(inflationErr).Str("chain", chain.Name).Msg("Could not fetch inflation")
+		return 0, inflationErr
+	}
+
+	if supplyErr != nil {
+		c.Logger.Warn().Err(supplyErr).Str("chain", chain.Name).Msg("Could not fetch supply")
+		return 0, supplyErr
+	}
+
+	if stakingInfoErr != nil {
+		c.Logger.Warn().Err(stakingInfoErr).Str("chain", chain.Name).Msg("Could not fetch staking info")
+		return 0, stakingInfoErr
+	}
+
+	return CalculateAPR(inflation.Mul(supply), bondedTokens, communityTax)
+}
+
+func (c *StandardAPRCalculator) Name() string {
+	return "standard"
+}
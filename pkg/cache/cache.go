@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -15,6 +16,9 @@ type Cache struct {
 	Logger    zerolog.Logger
 	StoreTime time.Duration
 	Entries   map[string]CacheEntry
+
+	// it's accessed from commands and watchers running concurrently
+	mutex sync.RWMutex
 }
 
 func NewCache() *Cache {
@@ -25,6 +29,9 @@ func NewCache() *Cache {
 }
 
 func (c *Cache) Get(key string) (interface{}, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
 	entry, found := c.Entries[key]
 	if !found {
 		return nil, false
@@ -39,6 +46,9 @@ func (c *Cache) Get(key string) (interface{}, bool) {
 }
 
 func (c *Cache) Set(key string, value interface{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
 	c.Entries[key] = CacheEntry{
 		Value:    value,
 		StoredAt: time.Now(),
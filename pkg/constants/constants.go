@@ -3,11 +3,16 @@ package constants
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 type FetcherName string
 type PriceFetcherName string
 
+// MintModule is the flavour of the chain mint module, which defines how
+// the chain inflation and therefore its staking APR are calculated.
+type MintModule string
+
 const (
 	ValidatorStatusBonded = "BOND_STATUS_BONDED"
 
@@ -15,15 +20,90 @@ const (
 
 	PriceFetcherNameCoingecko = "coingecko"
 
+	MintModuleStandard MintModule = "standard"
+	MintModuleCelestia MintModule = "celestia"
+	MintModuleOsmosis  MintModule = "osmosis"
+
 	PrometheusMetricsPrefix = "astronomer_"
 
 	RPCQueryTimeout = 10
 	RetriesCount    = 3
+
+	// APY is calculated assuming rewards are restaked once a day.
+	CompoundPeriodsPerYear = 365
+
+	TxsDefaultLimit = 5
+	TxsMaxLimit     = 50
+
+	ProposalsSearchPageSize = 5
+
+	// How many validators votes are queried at once.
+	ProposalVotesConcurrency = 10
+
+	// Max validators shown in /top and /compare tables.
+	ValidatorsTableMaxRows = 10
+
+	// How many validators at the bottom of the active set /active_set shows the margin of.
+	ActiveSetLastValidatorsCount = 5
+
+	// SLIP-44 coin type most Cosmos chains use, so a key has the same address bytes
+	// on all of them, and only the bech32 prefix differs.
+	CosmosCoinType = 118
+
+	// How long to wait for the Telegram webhook requests being handled on shutdown.
+	WebhookShutdownTimeout = 10 * time.Second
+
+	// Max txs fetched per wallet and query when watching new blocks.
+	WatcherTxsLimit = 100
+
+	// How long to wait before reconnecting to a websocket after it's disconnected.
+	WebsocketReconnectInterval = 5 * time.Second
+	// CometBFT pings websocket clients every ~27 seconds, so if nothing
+	// is received for longer than that, the connection is considered dead.
+	WebsocketReadTimeout = 60 * time.Second
+
+	// Websocket subscription query for new blocks.
+	NewBlockEventQuery = "tm.event='NewBlock'"
+	// CometBFT commit signature flag for validators that didn't vote for a block.
+	BlockIDFlagAbsent = 1
+	// Max page size of the CometBFT RPC /validators endpoint.
+	CometBFTValidatorsPerPage = 100
+	// How often to refetch the chain validator set, in blocks,
+	// it's also refetched when the number of signatures changes.
+	ValidatorSetRefreshBlocks = 100
+	// How often to check for chains that got RPC nodes added, to start ingesting blocks.
+	BlocksWatcherRefreshInterval = time.Minute
+	// How many cells the uptime heat-strip has, each one covering several blocks.
+	UptimeHeatStripCells = 20
+	// How many blocks to keep signatures of per chain, unless configured otherwise.
+	UptimeDefaultBlocksWindow = 1000
+
+	// Rough gas estimate for claiming rewards from a validator and delegating them back.
+	RestakeGasPerValidator = 250000
+
+	// Minimum gas price in the base denom assumed when calculating the restake fee
+	// if the node does not report one, it's the most common one for 6 decimals denoms.
+	DefaultMinimumGasPrice = "0.025"
+
+	// What happened to a notification, as a metrics label.
+	NotificationStatusSent   = "sent"
+	NotificationStatusQueued = "queued"
+	NotificationStatusMuted  = "muted"
 )
 
+// How long before the estimated upgrade time bound chats are notified about it.
+var UpgradeNotificationThresholds = []time.Duration{24 * time.Hour, time.Hour, 10 * time.Minute}
+
 var (
-	ErrWrongInvocation = errors.New("wrong invocation")
-	ErrChainNotFound   = fmt.Errorf("chain not found")
-	ErrChainNotBound   = fmt.Errorf("chain not bound to this chat")
-	ErrLCDNotFound     = fmt.Errorf("chain LCD host not found")
+	ErrWrongInvocation      = errors.New("wrong invocation")
+	ErrChainNotFound        = fmt.Errorf("chain not found")
+	ErrChainNotBound        = fmt.Errorf("chain not bound to this chat")
+	ErrLCDNotFound          = fmt.Errorf("chain LCD host not found")
+	ErrRPCNodeNotFound      = fmt.Errorf("chain RPC node not found")
+	ErrNoRPCNodes           = fmt.Errorf("no RPC nodes found")
+	ErrValidatorNotFound    = fmt.Errorf("validator not found")
+	ErrDigestNotEnabled     = fmt.Errorf("digest is not enabled for this chat")
+	ErrDenomNotFound        = fmt.Errorf("denom not found")
+	ErrPriceAlertNotFound   = fmt.Errorf("price alert not found")
+	ErrWhaleAlertNotEnabled = fmt.Errorf("whale alert is not enabled for this chain")
 )
@@ -2,7 +2,13 @@ package converter
 
 import (
 	"bytes"
+	"encoding/hex"
+	"strings"
 
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingTypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	authzTypes "github.com/cosmos/cosmos-sdk/x/authz"
+	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributionTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	upgradeTypes "cosmossdk.io/x/upgrade/types"
@@ -31,6 +37,11 @@ func NewConverter() *Converter {
 	paramsProposalTypes.RegisterInterfaces(interfaceRegistry)
 	upgradeTypes.RegisterInterfaces(interfaceRegistry)
 	distributionTypes.RegisterInterfaces(interfaceRegistry)
+	bankTypes.RegisterInterfaces(interfaceRegistry)
+	stakingTypes.RegisterInterfaces(interfaceRegistry)
+	authzTypes.RegisterInterfaces(interfaceRegistry)
+	authTypes.RegisterInterfaces(interfaceRegistry)
+	vestingTypes.RegisterInterfaces(interfaceRegistry)
 
 	parseCodec := codec.NewProtoCodec(interfaceRegistry)
 
@@ -44,10 +55,36 @@ func (c *Converter) Unmarshal(bytes []byte, target proto.Message) error {
 	return c.parseCodec.UnmarshalJSON(bytes, target)
 }
 
+// UnmarshalMessage decodes a single tx message serialized as JSON with its @type,
+// it fails if the message type is not registered.
+func (c *Converter) UnmarshalMessage(bytes []byte) (sdkTypes.Msg, error) {
+	var msg sdkTypes.Msg
+	if err := c.parseCodec.UnmarshalInterfaceJSON(bytes, &msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// UnpackAccount decodes an account returned by the auth module, which can be
+// a base, vesting or module account.
+func (c *Converter) UnpackAccount(account *codecTypes.Any) (sdkTypes.AccountI, error) {
+	var unpacked sdkTypes.AccountI
+	if err := c.parseCodec.UnpackAny(account, &unpacked); err != nil {
+		return nil, err
+	}
+
+	return unpacked, nil
+}
+
 func (c *Converter) UnpackProposal(proposal govV1beta1Types.Proposal) error {
 	return proposal.UnpackInterfaces(c.parseCodec)
 }
 
+func (c *Converter) UnpackProposalV1(proposal *govV1Types.Proposal) error {
+	return proposal.UnpackInterfaces(c.parseCodec)
+}
+
 func (c *Converter) GetValidatorConsAddr(validator stakingTypes.Validator) string {
 	if err := validator.UnpackInterfaces(c.parseCodec); err != nil {
 		panic(err)
@@ -61,6 +98,21 @@ func (c *Converter) GetValidatorConsAddr(validator stakingTypes.Validator) strin
 	return sdkTypes.ConsAddress(addr).String()
 }
 
+// GetValidatorConsAddrHex returns the validator consensus address as CometBFT
+// shows it, like in block signatures.
+func (c *Converter) GetValidatorConsAddrHex(validator stakingTypes.Validator) string {
+	if err := validator.UnpackInterfaces(c.parseCodec); err != nil {
+		panic(err)
+	}
+
+	addr, err := validator.GetConsAddr()
+	if err != nil {
+		panic(err)
+	}
+
+	return strings.ToUpper(hex.EncodeToString(addr))
+}
+
 func (c *Converter) CompareTwoBech32(first, second string) (bool, error) {
 	_, firstBytes, firstErr := bech32.Decode(first)
 	if firstErr != nil {
@@ -0,0 +1,71 @@
+package datafetcher
+
+import (
+	"main/pkg/types"
+	"sync"
+	"time"
+
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetAccountInfo returns the account type and its vesting schedule, if any,
+// along with how much of its balance is locked at the given time.
+func (f *DataFetcher) GetAccountInfo(chain *types.Chain, address string, now time.Time) types.AccountInfo {
+	var wg sync.WaitGroup
+
+	var (
+		account      *authTypes.QueryAccountResponse
+		accountErr   error
+		balances     *bankTypes.QueryAllBalancesResponse
+		balancesErr  error
+		spendable    *bankTypes.QuerySpendableBalancesResponse
+		spendableErr error
+	)
+
+	wg.Add(3)
+
+	go func() {
+		defer wg.Done()
+		account, accountErr = f.NodesManager.GetAccount(chain, address)
+	}()
+
+	go func() {
+		defer wg.Done()
+		balances, balancesErr = f.NodesManager.GetBalance(chain, address)
+	}()
+
+	go func() {
+		defer wg.Done()
+		spendable, spendableErr = f.NodesManager.GetSpendableBalances(chain, address)
+	}()
+
+	wg.Wait()
+
+	for _, err := range []error{accountErr, balancesErr, spendableErr} {
+		if err != nil {
+			return types.AccountInfo{Chain: chain, Address: address, Error: err}
+		}
+	}
+
+	// accounts of types we do not know about, like ethermint ones, are shown
+	// without the account details, but with their balances
+	unpacked, err := f.Converter.UnpackAccount(account.Account)
+	if err != nil {
+		f.Logger.Warn().
+			Err(err).
+			Str("chain", chain.Name).
+			Str("type", account.Account.GetTypeUrl()).
+			Msg("Could not unpack account, displaying it as unknown")
+		unpacked = nil
+	}
+
+	info := types.NewAccountInfo(chain, address, unpacked, balances.Balances, spendable.Balances, now)
+	if unpacked == nil {
+		info.TypeURL = account.Account.GetTypeUrl()
+	}
+
+	f.PopulateDenoms(info.GetAmounts())
+
+	return info
+}
@@ -0,0 +1,23 @@
+package datafetcher
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types"
+)
+
+// GetActiveSet returns the chain validators ordered by tokens with their margins
+// to the active set edge, with the given validators set as the linked ones.
+func (f *DataFetcher) GetActiveSet(chain *types.Chain, linkedAddresses []string) (*types.ActiveSet, error) {
+	validators, maxValidators, err := f.GetValidatorsWithMaxValidators(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	activeSet := types.NewActiveSet(chain, validators, maxValidators)
+	activeSet.SetLastValidators(constants.ActiveSetLastValidatorsCount)
+	activeSet.SetLinkedValidators(linkedAddresses)
+
+	f.PopulateDenoms(activeSet.GetDisplayedAmounts())
+
+	return activeSet, nil
+}
@@ -0,0 +1,47 @@
+package datafetcher
+
+import (
+	"main/pkg/types"
+	"sync"
+)
+
+func (f *DataFetcher) GetChainsAPR(chainNames []string) types.ChainsAPR {
+	response := types.ChainsAPR{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	chains, err := f.Database.GetChainsByNames(chainNames)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	chainsAPR := map[string]*types.ChainAPR{}
+
+	for _, chain := range chains {
+		chainsAPR[chain.Name] = &types.ChainAPR{
+			Chain: chain,
+		}
+
+		wg.Add(1)
+		go func(chain *types.Chain) {
+			defer wg.Done()
+
+			apr, aprErr := f.GetAPRCalculator(chain).GetAPR(chain)
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if aprErr != nil {
+				chainsAPR[chain.Name].Error = aprErr
+			} else {
+				chainsAPR[chain.Name].APR = apr
+			}
+		}(chain)
+	}
+
+	wg.Wait()
+
+	response.APRs = chainsAPR
+	return response
+}
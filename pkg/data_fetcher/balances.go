@@ -6,6 +6,7 @@ import (
 	"main/pkg/utils"
 	"sync"
 
+	"cosmossdk.io/math"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -58,6 +59,23 @@ func (f *DataFetcher) GetBalances(userID, reporter string) *types.WalletsBalance
 
 		response.SetChain(chain, explorers.GetExplorersByChain(chain.Name))
 
+		// APR, to estimate rewards
+		wg.Add(1)
+		go func(chain *types.Chain) {
+			defer wg.Done()
+
+			apr, aprErr := f.GetAPRCalculator(chain).GetAPR(chain)
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if aprErr != nil {
+				response.SetAPRError(chain.Name, aprErr)
+				return
+			}
+
+			response.SetAPR(chain.Name, apr)
+		}(chain)
+
 		for _, chainWallet := range chainWallets {
 			response.SetAddressInfo(chain.Name, chainWallet)
 
@@ -303,6 +321,23 @@ func (f *DataFetcher) GetBalances(userID, reporter string) *types.WalletsBalance
 			walletBalances.Delegations = utils.Filter(walletBalances.Delegations, func(d *types.Delegation) bool {
 				return !d.Amount.IsIgnored()
 			})
+
+			if chainBalances.APRError != nil {
+				continue
+			}
+
+			for _, delegation := range walletBalances.Delegations {
+				// validator was not fetched, so we do not know its commission
+				if delegation.Validator.Commission == nil {
+					continue
+				}
+
+				yearlyRate := math.LegacyMustNewDecFromStr(fmt.Sprintf("%.8f", chainBalances.APR)).
+					Mul(math.LegacyOneDec().Sub(*delegation.Validator.Commission))
+
+				delegation.Projection = types.NewRewardsProjection(delegation.Amount, yearlyRate)
+				walletBalances.Projection = walletBalances.Projection.Add(delegation.Projection)
+			}
 		}
 	}
 
@@ -0,0 +1,160 @@
+package datafetcher
+
+import (
+	"errors"
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"sync"
+
+	"cosmossdk.io/math"
+	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (f *DataFetcher) GetCompoundInfo(chain *types.Chain, address string) types.CompoundInfo {
+	response := types.CompoundInfo{
+		Chain:   chain,
+		Address: address,
+	}
+
+	var wg sync.WaitGroup
+
+	var (
+		delegations    *stakingTypes.QueryDelegatorDelegationsResponse
+		delegationsErr error
+		apr            float64
+		aprErr         error
+		gasPrice       math.LegacyDec
+		gasPriceErr    error
+	)
+
+	wg.Add(3)
+
+	go func() {
+		defer wg.Done()
+		delegations, delegationsErr = f.NodesManager.GetDelegations(chain, address)
+	}()
+
+	go func() {
+		defer wg.Done()
+		apr, aprErr = f.GetAPRCalculator(chain).GetAPR(chain)
+	}()
+
+	go func() {
+		defer wg.Done()
+		gasPrice, gasPriceErr = f.GetMinimumGasPrice(chain)
+	}()
+
+	wg.Wait()
+
+	if delegationsErr != nil {
+		response.Error = delegationsErr
+		return response
+	}
+
+	if aprErr != nil {
+		response.Error = aprErr
+		return response
+	}
+
+	if gasPriceErr != nil {
+		f.Logger.Warn().
+			Err(gasPriceErr).
+			Str("chain", chain.Name).
+			Str("default", constants.DefaultMinimumGasPrice).
+			Msg("Could not get minimum gas price, using the default one")
+		gasPrice = math.LegacyMustNewDecFromStr(constants.DefaultMinimumGasPrice)
+		response.DefaultGasPrice = constants.DefaultMinimumGasPrice
+	}
+
+	if len(delegations.DelegationResponses) == 0 {
+		return response
+	}
+
+	validators := utils.Map(delegations.DelegationResponses, func(d stakingTypes.DelegationResponse) *types.ValidatorAddressWithMoniker {
+		return &types.ValidatorAddressWithMoniker{
+			Chain:   chain,
+			Address: d.Delegation.ValidatorAddress,
+		}
+	})
+
+	f.PopulateValidators(validators)
+
+	totalStaked := math.LegacyZeroDec()
+	yearlyRewards := math.LegacyZeroDec()
+	chainAPR := math.LegacyMustNewDecFromStr(fmt.Sprintf("%.8f", apr))
+	restakedValidators := int64(0)
+
+	for index, delegation := range delegations.DelegationResponses {
+		// validator was not fetched, so we do not know its commission
+		if validators[index].Commission == nil {
+			continue
+		}
+
+		restakedValidators++
+		staked := delegation.Balance.Amount.ToLegacyDec()
+		totalStaked = totalStaked.Add(staked)
+		yearlyRewards = yearlyRewards.Add(
+			staked.Mul(chainAPR).Mul(math.LegacyOneDec().Sub(*validators[index].Commission)),
+		)
+	}
+
+	if !totalStaked.IsPositive() {
+		response.Error = errors.New("could not get validators for delegations")
+		return response
+	}
+
+	// each validator's rewards are claimed and delegated back in one transaction
+	restakeFee := gasPrice.MulInt64(constants.RestakeGasPerValidator * restakedValidators)
+
+	response.APR = yearlyRewards.Quo(totalStaked).MustFloat64()
+	restakesPerYear, rewardsWithRestake := utils.FindOptimalCompoundPeriods(
+		totalStaked.MustFloat64(),
+		response.APR,
+		restakeFee.MustFloat64(),
+		constants.CompoundPeriodsPerYear,
+	)
+
+	response.RestakesPerYear = restakesPerYear
+	response.APY = rewardsWithRestake / totalStaked.MustFloat64()
+
+	response.TotalStaked = &types.Amount{Amount: totalStaked, Denom: chain.BaseDenom}
+	response.RestakeFee = &types.Amount{Amount: restakeFee, Denom: chain.BaseDenom}
+	response.RewardsWithoutRestake = &types.Amount{Amount: yearlyRewards, Denom: chain.BaseDenom}
+	response.RewardsWithRestake = &types.Amount{
+		Amount: math.LegacyMustNewDecFromStr(fmt.Sprintf("%.6f", rewardsWithRestake)),
+		Denom:  chain.BaseDenom,
+	}
+
+	f.PopulateDenoms([]*types.AmountWithChain{
+		{Chain: chain.Name, Amount: response.TotalStaked},
+		{Chain: chain.Name, Amount: response.RestakeFee},
+		{Chain: chain.Name, Amount: response.RewardsWithoutRestake},
+		{Chain: chain.Name, Amount: response.RewardsWithRestake},
+	})
+
+	return response
+}
+
+// GetMinimumGasPrice returns the minimum gas price in chain's base denom
+// as set in the config of the node we are querying.
+func (f *DataFetcher) GetMinimumGasPrice(chain *types.Chain) (math.LegacyDec, error) {
+	config, err := f.NodesManager.GetNodeConfig(chain)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	gasPrices, err := cosmosTypes.ParseDecCoins(config.MinimumGasPrice)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	gasPrice := gasPrices.AmountOf(chain.BaseDenom)
+	if !gasPrice.IsPositive() {
+		return math.LegacyDec{}, fmt.Errorf("node does not report minimum gas price in %s", chain.BaseDenom)
+	}
+
+	return gasPrice, nil
+}
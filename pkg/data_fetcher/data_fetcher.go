@@ -1,6 +1,7 @@
 package datafetcher
 
 import (
+	aprCalculator "main/pkg/apr_calculator"
 	"main/pkg/cache"
 	"main/pkg/constants"
 	converterPkg "main/pkg/converter"
@@ -8,6 +9,8 @@ import (
 	"main/pkg/metrics"
 	priceFetcher "main/pkg/price_fetcher"
 	"main/pkg/tendermint"
+	"main/pkg/types"
+	"main/pkg/uptime"
 
 	"github.com/rs/zerolog"
 )
@@ -21,6 +24,12 @@ type DataFetcher struct {
 	Cache          *cache.Cache
 	RPCs           map[string]*tendermint.RPC
 	NodesManager   *tendermint.NodeManager
+	UptimeStore    *uptime.Store
+
+	// Calculators for custom mint modules, keyed by the chain mint module,
+	// chains with the standard one are using DefaultAPRCalculator.
+	APRCalculators       map[constants.MintModule]aprCalculator.APRCalculator
+	DefaultAPRCalculator aprCalculator.APRCalculator
 }
 
 func NewDataFetcher(
@@ -34,6 +43,11 @@ func NewDataFetcher(
 		constants.PriceFetcherNameCoingecko: priceFetcher.NewCoingeckoPriceFetcher(logger, metricsManager),
 	}
 
+	aprCalculators := map[constants.MintModule]aprCalculator.APRCalculator{
+		constants.MintModuleCelestia: aprCalculator.NewCelestiaAPRCalculator(logger, nodesManager),
+		constants.MintModuleOsmosis:  aprCalculator.NewOsmosisAPRCalculator(logger, nodesManager),
+	}
+
 	return &DataFetcher{
 		Logger:         logger.With().Str("component", "data_fetcher").Logger(),
 		Database:       database,
@@ -43,5 +57,17 @@ func NewDataFetcher(
 		Cache:          cache.NewCache(),
 		RPCs:           map[string]*tendermint.RPC{},
 		NodesManager:   nodesManager,
+		UptimeStore:    uptime.NewStore(constants.UptimeDefaultBlocksWindow),
+
+		APRCalculators:       aprCalculators,
+		DefaultAPRCalculator: aprCalculator.NewStandardAPRCalculator(logger, nodesManager),
 	}
 }
+
+func (f *DataFetcher) GetAPRCalculator(chain *types.Chain) aprCalculator.APRCalculator {
+	if calculator, ok := f.APRCalculators[chain.MintModule]; ok {
+		return calculator
+	}
+
+	return f.DefaultAPRCalculator
+}
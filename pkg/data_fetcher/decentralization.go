@@ -0,0 +1,114 @@
+package datafetcher
+
+import (
+	"main/pkg/types"
+	"sync"
+
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (f *DataFetcher) GetChainsDecentralization(chainNames []string) types.ChainsDecentralization {
+	chains, err := f.Database.GetChainsByNames(chainNames)
+	if err != nil {
+		return types.ChainsDecentralization{Error: err}
+	}
+
+	return f.GetDecentralization(chains)
+}
+
+func (f *DataFetcher) GetDecentralization(chains []*types.Chain) types.ChainsDecentralization {
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	response := types.ChainsDecentralization{
+		Chains: make(map[string]*types.ChainDecentralization, len(chains)),
+	}
+
+	for _, chain := range chains {
+		wg.Add(1)
+
+		go func(chain *types.Chain) {
+			defer wg.Done()
+
+			stats, statsErr := f.GetChainDecentralization(chain)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			response.Chains[chain.Name] = &types.ChainDecentralization{
+				Chain: chain,
+				Stats: stats,
+				Error: statsErr,
+			}
+		}(chain)
+	}
+
+	wg.Wait()
+
+	return response
+}
+
+// GetChainDecentralization calculates the chain decentralization stats,
+// also updating the corresponding metrics.
+func (f *DataFetcher) GetChainDecentralization(chain *types.Chain) (*types.DecentralizationStats, error) {
+	validators, maxValidators, err := f.GetValidatorsWithMaxValidators(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := types.NewDecentralizationStats(validators, maxValidators)
+	f.MetricsManager.LogDecentralizationStats(chain.Name, stats)
+
+	return stats, nil
+}
+
+// GetValidatorsWithMaxValidators fetches all the chain validators
+// and the max validators staking param at once.
+func (f *DataFetcher) GetValidatorsWithMaxValidators(chain *types.Chain) ([]stakingTypes.Validator, uint32, error) {
+	var wg sync.WaitGroup
+
+	var (
+		validators    []stakingTypes.Validator
+		validatorsErr error
+		maxValidators uint32
+		paramsErr     error
+	)
+
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+
+		response, err := f.NodesManager.GetAllValidators(chain)
+		if err != nil {
+			validatorsErr = err
+			return
+		}
+
+		validators = response.Validators
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		response, err := f.NodesManager.GetStakingParams(chain)
+		if err != nil {
+			paramsErr = err
+			return
+		}
+
+		maxValidators = response.Params.MaxValidators
+	}()
+
+	wg.Wait()
+
+	if validatorsErr != nil {
+		return nil, 0, validatorsErr
+	}
+
+	if paramsErr != nil {
+		return nil, 0, paramsErr
+	}
+
+	return validators, maxValidators, nil
+}
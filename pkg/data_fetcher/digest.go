@@ -0,0 +1,407 @@
+package datafetcher
+
+import (
+	"main/pkg/types"
+	"maps"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/guregu/null/v5"
+)
+
+// GetDigest summarizes the chains for the subscription digest, comparing the values
+// with the ones from the previous digest. Returns the digest and the values to compare
+// the next digest with.
+func (f *DataFetcher) GetDigest(
+	subscription *types.DigestSubscription,
+	chainNames []string,
+	now time.Time,
+) (*types.Digest, *types.DigestSnapshot, error) {
+	previous := subscription.Snapshot
+	if previous == nil {
+		previous = &types.DigestSnapshot{}
+	}
+
+	digest := &types.Digest{
+		Subscription: subscription,
+		Since:        subscription.LastSentAt,
+		Chains:       make([]*types.ChainDigest, 0),
+		Wallets:      make([]*types.WalletDigest, 0),
+	}
+	snapshot := &types.DigestSnapshot{
+		Chains:  map[string]*types.ChainDigestSnapshot{},
+		Wallets: map[string]map[string]float64{},
+	}
+
+	if len(chainNames) > 0 {
+		chainsDigests, err := f.GetChainsDigests(chainNames, subscription, previous, snapshot, now)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		digest.Chains = chainsDigests
+	}
+
+	if subscription.BalancesUserID.Valid {
+		digest.Wallets, digest.WalletsError = f.GetWalletsDigests(subscription, previous, snapshot)
+	}
+
+	return digest, snapshot, nil
+}
+
+func (f *DataFetcher) GetChainsDigests(
+	chainNames []string,
+	subscription *types.DigestSubscription,
+	previous *types.DigestSnapshot,
+	snapshot *types.DigestSnapshot,
+	now time.Time,
+) ([]*types.ChainDigest, error) {
+	chains, err := f.Database.GetChainsByNames(chainNames)
+	if err != nil {
+		return nil, err
+	}
+
+	explorers, err := f.Database.GetExplorersByChains(chainNames)
+	if err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	digests := make([]*types.ChainDigest, len(chains))
+	supplies := map[string][]*types.AmountWithChain{}
+	bondedTokens := map[string]float64{}
+	activeSets := map[string]map[string]string{}
+	amounts := []*types.AmountWithChain{}
+
+	for index, chain := range chains {
+		chainDigest := &types.ChainDigest{
+			Chain:     chain,
+			Explorers: explorers.GetExplorersByChain(chain.Name),
+		}
+		digests[index] = chainDigest
+
+		chainPrevious := previous.GetChain(chain.Name)
+
+		// Starting from the previous values, so the ones that failed to be fetched
+		// are compared with the next digest.
+		chainSnapshot := &types.ChainDigestSnapshot{
+			Supply:      chainPrevious.Supply,
+			BondedRatio: chainPrevious.BondedRatio,
+			ActiveSet:   chainPrevious.ActiveSet,
+			Prices:      map[string]float64{},
+		}
+		maps.Copy(chainSnapshot.Prices, chainPrevious.Prices)
+		snapshot.Chains[chain.Name] = chainSnapshot
+
+		wg.Add(4)
+
+		go func(chain *types.Chain) {
+			defer wg.Done()
+
+			proposals, proposalsErr := f.NodesManager.GetActiveProposals(chain)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if proposalsErr != nil {
+				chainDigest.ProposalsError = proposalsErr
+				return
+			}
+
+			for _, proposal := range proposals {
+				if proposal.VotingStartTime.After(subscription.LastSentAt) {
+					chainDigest.NewProposals = append(chainDigest.NewProposals, proposal)
+				}
+
+				if proposal.VotingEndTime.Before(now.Add(subscription.Period())) {
+					chainDigest.EndingProposals = append(chainDigest.EndingProposals, proposal)
+				}
+			}
+		}(chain)
+
+		go func(chain *types.Chain) {
+			defer wg.Done()
+
+			supply, supplyErr := f.NodesManager.GetSupply(chain)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if supplyErr != nil {
+				chainDigest.SupplyError = supplyErr
+				return
+			}
+
+			for _, coin := range supply.Supply {
+				amount := &types.AmountWithChain{Chain: chain.Name, Amount: types.AmountFrom(coin)}
+				supplies[chain.Name] = append(supplies[chain.Name], amount)
+				amounts = append(amounts, amount)
+			}
+		}(chain)
+
+		go func(chain *types.Chain) {
+			defer wg.Done()
+
+			pool, poolErr := f.NodesManager.GetPool(chain)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if poolErr != nil {
+				chainDigest.PoolError = poolErr
+				return
+			}
+
+			bondedTokens[chain.Name] = pool.Pool.BondedTokens.ToLegacyDec().MustFloat64()
+		}(chain)
+
+		go func(chain *types.Chain) {
+			defer wg.Done()
+
+			validators, validatorsErr := f.NodesManager.GetAllValidators(chain)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if validatorsErr != nil {
+				chainDigest.ValidatorsError = validatorsErr
+				return
+			}
+
+			activeSet := map[string]string{}
+			for _, validator := range validators.Validators {
+				if validator.IsBonded() {
+					activeSet[validator.OperatorAddress] = validator.Description.Moniker
+				}
+			}
+
+			activeSets[chain.Name] = activeSet
+		}(chain)
+	}
+
+	wg.Wait()
+
+	// Keeping the base denom supply amounts to calculate the bonded ratio,
+	// as they are converted to the display denom when populating denoms.
+	baseDenomSupplies := map[string]float64{}
+	for chainName, chainSupplies := range supplies {
+		for _, supply := range chainSupplies {
+			if supply.Amount.Denom == getChainBaseDenom(chains, chainName) {
+				baseDenomSupplies[chainName] = supply.Amount.Amount.MustFloat64()
+			}
+		}
+	}
+
+	f.PopulateDenoms(amounts)
+
+	for _, chainDigest := range digests {
+		chain := chainDigest.Chain
+		chainSnapshot := snapshot.Chains[chain.Name]
+		chainPrevious := previous.GetChain(chain.Name)
+
+		if chainDigest.SupplyError == nil {
+			f.SetDigestSupply(chainDigest, chainSnapshot, chainPrevious, supplies[chain.Name])
+		}
+
+		if bonded, ok := bondedTokens[chain.Name]; ok && baseDenomSupplies[chain.Name] > 0 {
+			bondedRatio := bonded / baseDenomSupplies[chain.Name]
+			chainDigest.BondedRatio = types.NewDigestChange(bondedRatio, chainPrevious.BondedRatio)
+			chainSnapshot.BondedRatio = null.FloatFrom(bondedRatio)
+		}
+
+		if activeSet, ok := activeSets[chain.Name]; ok {
+			chainDigest.ActiveSetSize = len(activeSet)
+			chainSnapshot.ActiveSet = activeSet
+
+			// Not showing all validators as joined on the first digest.
+			if chainPrevious.ActiveSet != nil {
+				chainDigest.JoinedActiveSet, chainDigest.LeftActiveSet = getActiveSetChanges(chainPrevious.ActiveSet, activeSet)
+			}
+		}
+	}
+
+	sort.Slice(digests, func(i, j int) bool {
+		return digests[i].Chain.Name < digests[j].Chain.Name
+	})
+
+	return digests, nil
+}
+
+// SetDigestSupply sets the chain base denom supply, and the prices of the supplied denoms
+// that have a price source.
+func (f *DataFetcher) SetDigestSupply(
+	chainDigest *types.ChainDigest,
+	chainSnapshot *types.ChainDigestSnapshot,
+	chainPrevious *types.ChainDigestSnapshot,
+	supplies []*types.AmountWithChain,
+) {
+	for _, supply := range supplies {
+		if supply.Amount.IsIgnored() {
+			continue
+		}
+
+		if supply.Amount.BaseDenom == chainDigest.Chain.BaseDenom {
+			chainDigest.Supply = supply.Amount
+			chainDigest.SupplyChange = types.NewDigestChange(supply.Amount.Amount.MustFloat64(), chainPrevious.Supply)
+			chainSnapshot.Supply = null.FloatFrom(chainDigest.SupplyChange.Current)
+		}
+
+		if supply.Amount.PriceUSD == nil || supply.Amount.Amount.IsZero() {
+			continue
+		}
+
+		price := supply.Amount.PriceUSD.Quo(supply.Amount.Amount).MustFloat64()
+		previousPrice, found := chainPrevious.Prices[supply.Amount.Denom]
+
+		chainDigest.Prices = append(chainDigest.Prices, &types.DigestPrice{
+			Denom: supply.Amount.Denom,
+			Price: types.NewDigestChange(price, null.NewFloat(previousPrice, found)),
+		})
+		chainSnapshot.Prices[supply.Amount.Denom] = price
+	}
+
+	sort.Slice(chainDigest.Prices, func(i, j int) bool {
+		return chainDigest.Prices[i].Denom < chainDigest.Prices[j].Denom
+	})
+}
+
+// GetWalletsDigests compares the user wallets amounts, summing up their balances,
+// delegations, unbonds and rewards by denom.
+func (f *DataFetcher) GetWalletsDigests(
+	subscription *types.DigestSubscription,
+	previous *types.DigestSnapshot,
+	snapshot *types.DigestSnapshot,
+) ([]*types.WalletDigest, error) {
+	balances := f.GetBalances(subscription.BalancesUserID.String, subscription.Reporter)
+	if balances.Error != nil {
+		maps.Copy(snapshot.Wallets, previous.Wallets)
+		return []*types.WalletDigest{}, balances.Error
+	}
+
+	digests := make([]*types.WalletDigest, 0)
+
+	for _, chainBalances := range balances.Infos {
+		for _, walletBalances := range chainBalances.BalancesInfo {
+			key := types.GetDigestWalletKey(chainBalances.Chain.Name, walletBalances.Address.Address)
+			walletDigest := &types.WalletDigest{
+				Chain:   chainBalances.Chain,
+				Wallet:  walletBalances.Address,
+				Amounts: make([]*types.DigestAmount, 0),
+			}
+			digests = append(digests, walletDigest)
+
+			walletDigest.Error = getWalletBalancesError(walletBalances)
+			if walletDigest.Error != nil {
+				if previousAmounts, ok := previous.Wallets[key]; ok {
+					snapshot.Wallets[key] = previousAmounts
+				}
+				continue
+			}
+
+			totals := sumWalletAmounts(walletBalances)
+			snapshot.Wallets[key] = totals
+
+			previousAmounts, hasPrevious := previous.Wallets[key]
+
+			denoms := make([]string, 0, len(totals))
+			for denom := range totals {
+				denoms = append(denoms, denom)
+			}
+
+			sort.Strings(denoms)
+
+			for _, denom := range denoms {
+				walletDigest.Amounts = append(walletDigest.Amounts, &types.DigestAmount{
+					Denom:  denom,
+					Amount: types.NewDigestChange(totals[denom], null.NewFloat(previousAmounts[denom], hasPrevious)),
+				})
+			}
+		}
+	}
+
+	sort.Slice(digests, func(i, j int) bool {
+		if digests[i].Chain.Name != digests[j].Chain.Name {
+			return digests[i].Chain.Name < digests[j].Chain.Name
+		}
+
+		return digests[i].Wallet.Address < digests[j].Wallet.Address
+	})
+
+	return digests, nil
+}
+
+func getChainBaseDenom(chains []*types.Chain, chainName string) string {
+	for _, chain := range chains {
+		if chain.Name == chainName {
+			return chain.BaseDenom
+		}
+	}
+
+	return ""
+}
+
+func getActiveSetChanges(previous, current map[string]string) ([]string, []string) {
+	joined := make([]string, 0)
+	left := make([]string, 0)
+
+	for address, moniker := range current {
+		if _, ok := previous[address]; !ok {
+			joined = append(joined, moniker)
+		}
+	}
+
+	for address, moniker := range previous {
+		if _, ok := current[address]; !ok {
+			left = append(left, moniker)
+		}
+	}
+
+	sort.Strings(joined)
+	sort.Strings(left)
+
+	return joined, left
+}
+
+func getWalletBalancesError(balances *types.WalletBalancesInfo) error {
+	for _, err := range []error{
+		balances.BalancesError,
+		balances.DelegationsError,
+		balances.UnbondsError,
+		balances.RewardsError,
+	} {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func sumWalletAmounts(balances *types.WalletBalancesInfo) map[string]float64 {
+	amounts := make([]*types.Amount, 0)
+	amounts = append(amounts, balances.Balances...)
+	amounts = append(amounts, balances.Rewards...)
+	amounts = append(amounts, balances.Commissions...)
+
+	for _, delegation := range balances.Delegations {
+		amounts = append(amounts, delegation.Amount)
+	}
+
+	for _, unbond := range balances.Unbonds {
+		amounts = append(amounts, unbond.Amount)
+	}
+
+	totals := map[string]float64{}
+	for _, amount := range amounts {
+		if amount.IsIgnored() {
+			continue
+		}
+
+		totals[amount.Denom] += amount.Amount.MustFloat64()
+	}
+
+	return totals
+}
@@ -143,6 +143,21 @@ func (f *DataFetcher) GetChainsParams(chainNames []string) types.ChainsParams {
 				chainsParams[chain.Name].Inflation = inflation.Inflation
 			}
 		}(chain)
+
+		wg.Add(1)
+		go func(chain *types.Chain) {
+			defer wg.Done()
+
+			stats, statsErr := f.GetChainDecentralization(chain)
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if statsErr != nil {
+				chainsParams[chain.Name].DecentralizationError = statsErr
+			} else {
+				chainsParams[chain.Name].Decentralization = stats
+			}
+		}(chain)
 	}
 
 	wg.Wait()
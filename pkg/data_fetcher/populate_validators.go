@@ -23,6 +23,7 @@ func (f *DataFetcher) PopulateValidators(validators []*types.ValidatorAddressWit
 
 			mutex.Lock()
 			validator.Moniker = validatorFromChain.Validator.Description.Moniker
+			validator.Commission = &validatorFromChain.Validator.Commission.CommissionRates.Rate
 			mutex.Unlock()
 		}(validator)
 	}
@@ -0,0 +1,170 @@
+package datafetcher
+
+import (
+	"fmt"
+	"main/pkg/constants"
+	priceFetcher "main/pkg/price_fetcher"
+	"main/pkg/types"
+	"main/pkg/utils"
+
+	"github.com/guregu/null/v5"
+)
+
+func (f *DataFetcher) GetDenomChangeCacheKey(chain, denom string) string {
+	return fmt.Sprintf("denom_change_%s_%s", chain, denom)
+}
+
+// GetPrices returns the prices of the chains denoms having a price source,
+// or of a single denom, matched by its denom or display denom, if it's set.
+func (f *DataFetcher) GetPrices(chainNames []string, denomName string) types.PricesInfo {
+	response := types.PricesInfo{}
+
+	chains, err := f.Database.GetChainsByNames(chainNames)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	allDenoms := types.Denoms{}
+	chainsPrices := make([]*types.ChainPrices, len(chains))
+
+	for index, chain := range chains {
+		chainsPrices[index] = &types.ChainPrices{Chain: chain, Prices: []*types.DenomPrice{}}
+
+		denoms, err := f.Database.GetDenomsByChain(chain)
+		if err != nil {
+			chainsPrices[index].Error = err
+			continue
+		}
+
+		for _, denom := range denoms {
+			if denom.Ignored || denom.CoingeckoCurrency.IsZero() {
+				continue
+			}
+
+			if denomName != "" && denom.Denom != denomName && denom.DisplayDenom != denomName {
+				continue
+			}
+
+			allDenoms = append(allDenoms, denom)
+		}
+	}
+
+	prices := f.GetDenomsPrices(allDenoms, true)
+
+	for _, chainPrices := range chainsPrices {
+		for _, price := range prices {
+			if price.Denom.Chain == chainPrices.Chain.Name {
+				chainPrices.Prices = append(chainPrices.Prices, price)
+			}
+		}
+	}
+
+	response.Chains = chainsPrices
+	return response
+}
+
+// GetDenomsPrices returns the denoms prices, in the same order, using the cached ones if possible.
+// Denoms without a price source are skipped. If withChanges is set, the 24h changes are also
+// returned for the price sources supporting them.
+func (f *DataFetcher) GetDenomsPrices(denoms types.Denoms, withChanges bool) []*types.DenomPrice {
+	denomsByPriceFetcher := utils.GroupBy(denoms, func(d *types.Denom) []constants.PriceFetcherName {
+		if d.CoingeckoCurrency.IsZero() {
+			return []constants.PriceFetcherName{}
+		}
+
+		return []constants.PriceFetcherName{constants.PriceFetcherNameCoingecko}
+	})
+
+	prices := priceFetcher.Prices{}
+	changes := priceFetcher.Prices{}
+
+	for priceFetcherName, fetcherDenoms := range denomsByPriceFetcher {
+		foundPriceFetcher, ok := f.PriceFetchers[priceFetcherName]
+		if !ok {
+			continue
+		}
+
+		changesFetcher, supportsChanges := foundPriceFetcher.(priceFetcher.PriceChangesFetcher)
+		fetchChanges := withChanges && supportsChanges
+
+		notCachedDenoms := []*types.Denom{}
+
+		for _, denom := range fetcherDenoms {
+			price, priceCached := f.Cache.Get(f.GetDenomCacheKey(denom.Chain, denom.Denom))
+			change, changeCached := f.Cache.Get(f.GetDenomChangeCacheKey(denom.Chain, denom.Denom))
+
+			if !priceCached || (fetchChanges && !changeCached) {
+				notCachedDenoms = append(notCachedDenoms, denom)
+				continue
+			}
+
+			priceFloat, _ := price.(float64)
+			prices.Set(denom.Chain, denom.Denom, priceFloat)
+
+			if changeCached {
+				changeFloat, _ := change.(float64)
+				changes.Set(denom.Chain, denom.Denom, changeFloat)
+			}
+		}
+
+		if len(notCachedDenoms) == 0 {
+			continue
+		}
+
+		var (
+			fetcherPrices  priceFetcher.Prices
+			fetcherChanges priceFetcher.Prices
+			err            error
+		)
+
+		if fetchChanges {
+			fetcherPrices, fetcherChanges, err = changesFetcher.GetPricesWithChanges(notCachedDenoms)
+		} else {
+			fetcherPrices, err = foundPriceFetcher.GetPrices(notCachedDenoms)
+		}
+
+		if err != nil {
+			f.Logger.Err(err).
+				Str("price_fetcher", string(priceFetcherName)).
+				Msg("Could not fetch prices")
+			continue
+		}
+
+		for chain, chainPrices := range fetcherPrices {
+			for denom, value := range chainPrices {
+				f.Cache.Set(f.GetDenomCacheKey(chain, denom), value)
+				prices.Set(chain, denom, value)
+			}
+		}
+
+		for chain, chainChanges := range fetcherChanges {
+			for denom, value := range chainChanges {
+				f.Cache.Set(f.GetDenomChangeCacheKey(chain, denom), value)
+				changes.Set(chain, denom, value)
+			}
+		}
+	}
+
+	result := make([]*types.DenomPrice, 0, len(denoms))
+
+	for _, denom := range denoms {
+		if denom.CoingeckoCurrency.IsZero() {
+			continue
+		}
+
+		denomPrice := &types.DenomPrice{Denom: denom}
+
+		if price, found := prices.Get(denom.Chain, denom.Denom); found {
+			denomPrice.Price = null.FloatFrom(price)
+		}
+
+		if change, found := changes.Get(denom.Chain, denom.Denom); found {
+			denomPrice.Change24h = null.FloatFrom(change)
+		}
+
+		result = append(result, denomPrice)
+	}
+
+	return result
+}
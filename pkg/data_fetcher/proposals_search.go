@@ -0,0 +1,82 @@
+package datafetcher
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SearchProposals returns a page of the chain proposals with the given status,
+// the title or summary of which contain the query, newest first.
+func (f *DataFetcher) SearchProposals(
+	chain *types.Chain,
+	status string,
+	query string,
+	page int,
+) types.ProposalsSearch {
+	response := types.ProposalsSearch{
+		Chain:  chain,
+		Status: status,
+		Query:  query,
+		Page:   page,
+	}
+
+	explorers, err := f.Database.GetExplorersByChains([]string{chain.Name})
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	response.Explorers = explorers.GetExplorersByChain(chain.Name)
+
+	proposals, err := f.GetProposalsCached(chain, status)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	lowercaseQuery := strings.ToLower(query)
+	proposals = utils.Filter(proposals, func(p *types.Proposal) bool {
+		return strings.Contains(strings.ToLower(p.Title), lowercaseQuery) ||
+			strings.Contains(strings.ToLower(p.Summary), lowercaseQuery)
+	})
+
+	sort.Slice(proposals, func(i, j int) bool {
+		first, _ := strconv.ParseUint(proposals[i].ID, 10, 64)
+		second, _ := strconv.ParseUint(proposals[j].ID, 10, 64)
+		return first > second
+	})
+
+	response.TotalCount = len(proposals)
+	response.PagesCount = (len(proposals) + constants.ProposalsSearchPageSize - 1) / constants.ProposalsSearchPageSize
+
+	from := min((page-1)*constants.ProposalsSearchPageSize, len(proposals))
+	to := min(from+constants.ProposalsSearchPageSize, len(proposals))
+	response.Proposals = proposals[from:to]
+
+	return response
+}
+
+// GetProposalsCached returns the chain proposals with the given status, caching them,
+// so paging through the search results does not refetch the whole proposals history.
+// The cached list is shared, so callers should not modify it in place.
+func (f *DataFetcher) GetProposalsCached(chain *types.Chain, status string) ([]*types.Proposal, error) {
+	cacheKey := "proposals_" + chain.Name + "_" + status
+
+	if cached, found := f.Cache.Get(cacheKey); found {
+		if proposals, ok := cached.([]*types.Proposal); ok {
+			return proposals, nil
+		}
+	}
+
+	proposals, err := f.NodesManager.GetProposals(chain, types.ProposalStatusFilters[status])
+	if err != nil {
+		return nil, err
+	}
+
+	f.Cache.Set(cacheKey, proposals)
+	return proposals, nil
+}
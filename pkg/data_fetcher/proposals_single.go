@@ -2,6 +2,7 @@ package datafetcher
 
 import (
 	"main/pkg/types"
+	"sync"
 )
 
 func (f *DataFetcher) GetSingleProposal(chain *types.Chain, proposalID string) types.SingleProposal {
@@ -20,9 +21,68 @@ func (f *DataFetcher) GetSingleProposal(chain *types.Chain, proposalID string) t
 
 	if err != nil {
 		response.Error = err
-	} else {
-		response.Proposal = proposal
+		return response
+	}
+
+	response.Proposal = proposal
+	if proposal == nil {
+		return response
+	}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	wg.Add(3)
+
+	go func() {
+		defer wg.Done()
+
+		tally, tallyErr := f.NodesManager.GetProposalTally(chain, proposalID)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		response.Tally, response.TallyError = tally, tallyErr
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		pool, poolErr := f.NodesManager.GetPool(chain)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if poolErr != nil {
+			response.PoolError = poolErr
+		} else {
+			response.BondedTokens = pool.Pool.BondedTokens
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		params, paramsErr := f.NodesManager.GetGovParams(chain, "tallying")
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if paramsErr != nil {
+			response.TallyParamsError = paramsErr
+		} else {
+			response.TallyParams = params.TallyParams
+		}
+	}()
+
+	wg.Wait()
+
+	amounts := make([]*types.AmountWithChain, len(proposal.TotalDeposit))
+	for index, deposit := range proposal.TotalDeposit {
+		amounts[index] = &types.AmountWithChain{Chain: chain.Name, Amount: deposit}
 	}
 
+	f.PopulateDenoms(amounts)
+
 	return response
 }
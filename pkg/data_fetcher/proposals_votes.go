@@ -0,0 +1,99 @@
+package datafetcher
+
+import (
+	"main/pkg/types"
+	"main/pkg/utils"
+
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (f *DataFetcher) GetProposalVotes(chain *types.Chain, proposalID string) types.ProposalVotes {
+	response := types.ProposalVotes{Chain: chain}
+
+	explorers, err := f.Database.GetExplorersByChains([]string{chain.Name})
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	response.Explorers = explorers.GetExplorersByChain(chain.Name)
+
+	proposal, err := f.NodesManager.GetSingleProposal(chain, proposalID)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	response.Proposal = proposal
+	if proposal == nil {
+		return response
+	}
+
+	validatorsResponse, err := f.NodesManager.GetAllValidators(chain)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	activeValidators := utils.Filter(validatorsResponse.Validators, func(v stakingTypes.Validator) bool {
+		return v.Status == stakingTypes.Bonded
+	})
+	totalVP := utils.GetTotalVP(activeValidators)
+
+	validatorsByVoter := make(map[string]*types.ValidatorVote, len(activeValidators))
+	voters := make([]string, 0, len(activeValidators))
+
+	for _, validator := range activeValidators {
+		voter, convertErr := utils.ConvertBech32Prefix(validator.OperatorAddress, chain.Bech32AccountPrefix)
+		if convertErr != nil {
+			f.Logger.Warn().
+				Err(convertErr).
+				Str("chain", chain.Name).
+				Str("operator_address", validator.OperatorAddress).
+				Msg("Could not convert validator address to account address")
+			continue
+		}
+
+		votingPower := 0.0
+		if !totalVP.IsZero() {
+			votingPower = validator.DelegatorShares.Quo(totalVP).MustFloat64()
+		}
+
+		validatorsByVoter[voter] = &types.ValidatorVote{
+			Moniker:         validator.Description.Moniker,
+			OperatorAddress: validator.OperatorAddress,
+			VotingPower:     votingPower,
+			Weight:          1,
+		}
+		voters = append(voters, voter)
+	}
+
+	votes, votesErrors, err := f.NodesManager.GetProposalVotes(chain, proposalID, voters)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	response.Groups = []*types.ProposalVotesGroup{}
+	response.NotVoted = &types.ProposalVotesGroup{Validators: []*types.ValidatorVote{}}
+	response.Unknown = &types.ProposalVotesGroup{Validators: []*types.ValidatorVote{}}
+
+	for _, voter := range voters {
+		validator := validatorsByVoter[voter]
+
+		if _, ok := votesErrors[voter]; ok {
+			response.Unknown.Validators = append(response.Unknown.Validators, validator)
+			continue
+		}
+
+		if vote := votes[voter]; vote != nil {
+			response.AddVote(validator, vote)
+		} else {
+			response.NotVoted.Validators = append(response.NotVoted.Validators, validator)
+		}
+	}
+
+	response.SortGroups()
+
+	return response
+}
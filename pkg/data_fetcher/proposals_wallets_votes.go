@@ -0,0 +1,82 @@
+package datafetcher
+
+import (
+	"main/pkg/types"
+	"main/pkg/utils"
+)
+
+// GetProposalWalletsVotes returns how the user's wallets linked on this chain
+// and the validators they delegate to have voted on the proposal.
+func (f *DataFetcher) GetProposalWalletsVotes(
+	chain *types.Chain,
+	proposalID string,
+	userID string,
+	reporter string,
+) ([]*types.WalletProposalVotes, error) {
+	wallets, err := f.Database.FindWalletLinksByUserAndReporter(userID, reporter)
+	if err != nil {
+		return nil, err
+	}
+
+	chainWallets := utils.Filter(wallets, func(w *types.WalletLink) bool {
+		return w.Chain == chain.Name
+	})
+
+	response := make([]*types.WalletProposalVotes, len(chainWallets))
+	validators := []*types.ValidatorAddressWithMoniker{}
+
+	for index, wallet := range chainWallets {
+		walletVotes := &types.WalletProposalVotes{
+			Wallet:     wallet,
+			Validators: []*types.DelegatedValidatorVote{},
+		}
+		response[index] = walletVotes
+
+		delegations, delegationsErr := f.NodesManager.GetDelegations(chain, wallet.Address)
+		if delegationsErr != nil {
+			walletVotes.Error = delegationsErr
+			continue
+		}
+
+		voters := []string{wallet.Address}
+		votersValidators := map[string]*types.DelegatedValidatorVote{}
+
+		for _, delegation := range delegations.DelegationResponses {
+			validatorAddress := delegation.Delegation.ValidatorAddress
+
+			voter, convertErr := utils.ConvertBech32Prefix(validatorAddress, chain.Bech32AccountPrefix)
+			if convertErr != nil {
+				walletVotes.Validators = append(walletVotes.Validators, &types.DelegatedValidatorVote{
+					Validator: &types.ValidatorAddressWithMoniker{Chain: chain, Address: validatorAddress},
+					Error:     convertErr,
+				})
+				continue
+			}
+
+			validatorVote := &types.DelegatedValidatorVote{
+				Validator: &types.ValidatorAddressWithMoniker{Chain: chain, Address: validatorAddress},
+			}
+
+			walletVotes.Validators = append(walletVotes.Validators, validatorVote)
+			validators = append(validators, validatorVote.Validator)
+			votersValidators[voter] = validatorVote
+			voters = append(voters, voter)
+		}
+
+		votes, votesErrors, votesErr := f.NodesManager.GetProposalVotes(chain, proposalID, voters)
+		if votesErr != nil {
+			walletVotes.Error = votesErr
+			continue
+		}
+
+		walletVotes.Vote, walletVotes.VoteError = votes[wallet.Address], votesErrors[wallet.Address]
+
+		for voter, validatorVote := range votersValidators {
+			validatorVote.Vote, validatorVote.Error = votes[voter], votesErrors[voter]
+		}
+	}
+
+	f.PopulateValidators(validators)
+
+	return response, nil
+}
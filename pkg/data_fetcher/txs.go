@@ -0,0 +1,462 @@
+package datafetcher
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/math"
+	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
+	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributionTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govV1beta1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	MsgExecTypeURL       = "/cosmos.authz.v1beta1.MsgExec"
+	MsgTransferTypeURL   = "/ibc.applications.transfer.v1.MsgTransfer"
+	MsgRecvPacketTypeURL = "/ibc.core.channel.v1.MsgRecvPacket"
+
+	IBCTransferPort = "transfer"
+)
+
+func (f *DataFetcher) GetWalletsTxs(userID, reporter, alias string, limit int) types.WalletsTxs {
+	response := types.WalletsTxs{}
+
+	wallets, err := f.Database.FindWalletLinksByUserAndReporter(userID, reporter)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	if alias != "" {
+		wallets = utils.Filter(wallets, func(w *types.WalletLink) bool {
+			return strings.EqualFold(w.Alias.String, alias)
+		})
+	}
+
+	chainNames := utils.MapUniq(wallets, func(w *types.WalletLink) string {
+		return w.Chain
+	})
+
+	chains, err := f.Database.GetChainsByNames(chainNames)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	explorers, err := f.Database.GetExplorersByChains(chainNames)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	chainsMap := utils.GroupSingleBy(chains, func(c *types.Chain) string {
+		return c.Name
+	})
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	txResponses := map[*types.WalletTxs]map[string]types.TxResponse{}
+	response.Wallets = make([]*types.WalletTxs, 0, len(wallets))
+
+	for _, wallet := range wallets {
+		chain, ok := chainsMap[wallet.Chain]
+		if !ok {
+			panic(fmt.Errorf("chain %s not found", wallet.Chain))
+		}
+
+		walletTxs := &types.WalletTxs{
+			Chain:     chain,
+			Explorers: explorers.GetExplorersByChain(chain.Name),
+			Wallet:    wallet,
+		}
+
+		response.Wallets = append(response.Wallets, walletTxs)
+		txResponses[walletTxs] = map[string]types.TxResponse{}
+
+		// txs sent by this wallet and txs sending tokens to it
+		queries := [][]string{
+			{fmt.Sprintf("message.sender='%s'", wallet.Address)},
+			{fmt.Sprintf("transfer.recipient='%s'", wallet.Address)},
+		}
+
+		for _, query := range queries {
+			wg.Add(1)
+			go func(walletTxs *types.WalletTxs, query []string) {
+				defer wg.Done()
+
+				txs, txsErr := f.NodesManager.GetTxs(walletTxs.Chain, query, limit)
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if txsErr != nil {
+					walletTxs.Error = txsErr
+					return
+				}
+
+				for _, tx := range txs.TxResponses {
+					txResponses[walletTxs][tx.TxHash] = tx
+				}
+			}(walletTxs, query)
+		}
+	}
+
+	wg.Wait()
+
+	amounts := []*types.AmountWithChain{}
+
+	for _, walletTxs := range response.Wallets {
+		if walletTxs.Error != nil {
+			continue
+		}
+
+		// both queries return the latest txs, so merging them and taking the first ones
+		walletTxResponses := sortTxResponses(txResponses[walletTxs])
+		if len(walletTxResponses) > limit {
+			walletTxResponses = walletTxResponses[:limit]
+		}
+
+		walletTxs.Txs = utils.Map(walletTxResponses, func(txResponse types.TxResponse) *types.Tx {
+			return f.ParseTx(txResponse, walletTxs.Wallet.Address)
+		})
+
+		for _, tx := range walletTxs.Txs {
+			for _, message := range tx.Messages {
+				for _, amount := range message.GetAllAmounts() {
+					amounts = append(amounts, &types.AmountWithChain{
+						Chain:  walletTxs.Chain.Name,
+						Amount: amount,
+					})
+				}
+			}
+		}
+	}
+
+	f.PopulateDenoms(amounts)
+
+	return response
+}
+
+// GetWalletsNewTxs returns successful txs involving the wallets linked on this chain
+// and included in blocks from fromHeight (exclusive) to toHeight (inclusive),
+// one per tx and wallet link, with messages not matching the link threshold filtered out.
+func (f *DataFetcher) GetWalletsNewTxs(
+	chain *types.Chain,
+	explorers types.Explorers,
+	wallets []*types.WalletLink,
+	fromHeight int64,
+	toHeight int64,
+) ([]*types.WalletTxNotification, error) {
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var queryErr error
+
+	// keyed by address, as the same wallet can be linked by multiple users
+	txResponses := map[string]map[string]types.TxResponse{}
+
+	for _, wallet := range wallets {
+		address := wallet.Address
+		if _, ok := txResponses[address]; ok {
+			continue
+		}
+
+		txResponses[address] = map[string]types.TxResponse{}
+
+		// each event has to have exactly one "=" for chains only accepting events,
+		// so using >= instead of > for the lower bound
+		heightEvents := []string{
+			fmt.Sprintf("tx.height>=%d", fromHeight+1),
+			fmt.Sprintf("tx.height<=%d", toHeight),
+		}
+		queries := [][]string{
+			append([]string{fmt.Sprintf("message.sender='%s'", address)}, heightEvents...),
+			append([]string{fmt.Sprintf("transfer.recipient='%s'", address)}, heightEvents...),
+		}
+
+		for _, query := range queries {
+			wg.Add(1)
+			go func(address string, query []string) {
+				defer wg.Done()
+
+				// all the pages, as busy wallets or catching up after a downtime
+				// can have more txs than fit in one, and the rest would be skipped
+				txs, txsErr := f.NodesManager.GetAllTxs(chain, query, constants.WatcherTxsLimit)
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if txsErr != nil {
+					queryErr = txsErr
+					return
+				}
+
+				for _, tx := range txs.TxResponses {
+					txResponses[address][tx.TxHash] = tx
+				}
+			}(address, query)
+		}
+	}
+
+	wg.Wait()
+
+	if queryErr != nil {
+		return nil, queryErr
+	}
+
+	notifications := make([]*types.WalletTxNotification, 0)
+	amounts := []*types.AmountWithChain{}
+
+	for _, wallet := range wallets {
+		for _, txResponse := range sortTxResponses(txResponses[wallet.Address]) {
+			if txResponse.Code != 0 {
+				continue
+			}
+
+			tx := f.ParseTx(txResponse, wallet.Address)
+			notifications = append(notifications, &types.WalletTxNotification{
+				Chain:     chain,
+				Explorers: explorers.GetExplorersByChain(chain.Name),
+				Wallet:    wallet,
+				Tx:        tx,
+			})
+
+			for _, message := range tx.Messages {
+				for _, amount := range message.GetAllAmounts() {
+					amounts = append(amounts, &types.AmountWithChain{
+						Chain:  chain.Name,
+						Amount: amount,
+					})
+				}
+			}
+		}
+	}
+
+	// thresholds are in display denoms, so filtering only after the denoms are populated
+	f.PopulateDenoms(amounts)
+
+	filtered := make([]*types.WalletTxNotification, 0, len(notifications))
+	for _, notification := range notifications {
+		notification.Tx.Messages = types.FilterWalletMessages(
+			notification.Tx.Messages,
+			notification.Wallet.Address,
+			notification.Wallet.NotifyThreshold,
+		)
+
+		if len(notification.Tx.Messages) > 0 {
+			filtered = append(filtered, notification)
+		}
+	}
+
+	return filtered, nil
+}
+
+// sortTxResponses returns the txs ordered from the latest to the oldest.
+func sortTxResponses(txResponses map[string]types.TxResponse) []types.TxResponse {
+	sorted := make([]types.TxResponse, 0, len(txResponses))
+	for _, tx := range txResponses {
+		sorted = append(sorted, tx)
+	}
+
+	slices.SortFunc(sorted, func(a, b types.TxResponse) int {
+		aHeight, _ := strconv.ParseInt(a.Height, 10, 64)
+		bHeight, _ := strconv.ParseInt(b.Height, 10, 64)
+
+		if aHeight != bHeight {
+			return cmp.Compare(bHeight, aHeight)
+		}
+
+		return strings.Compare(a.TxHash, b.TxHash)
+	})
+
+	return sorted
+}
+
+func (f *DataFetcher) ParseTx(txResponse types.TxResponse, walletAddress string) *types.Tx {
+	return &types.Tx{
+		Hash:      txResponse.TxHash,
+		Height:    txResponse.Height,
+		Timestamp: txResponse.Timestamp,
+		Success:   txResponse.Code == 0,
+		Memo:      txResponse.Tx.Body.Memo,
+		Messages: utils.Map(txResponse.Tx.Body.Messages, func(raw json.RawMessage) *types.TxMessage {
+			return f.ParseTxMessage(raw, walletAddress)
+		}),
+	}
+}
+
+// ParseTxMessage converts a tx message into something that can be displayed.
+// Authz exec and IBC transfers and receives are parsed manually, as for the former
+// we need to parse its inner messages separately (as some of them might be unsupported),
+// and for the latter we do not have the protobuf types.
+func (f *DataFetcher) ParseTxMessage(raw json.RawMessage, walletAddress string) *types.TxMessage {
+	var rawMessage types.RawTxMessage
+	if err := json.Unmarshal(raw, &rawMessage); err != nil {
+		return &types.TxMessage{Type: types.TxMessageTypeUnsupported}
+	}
+
+	switch rawMessage.Type {
+	case MsgExecTypeURL:
+		var exec types.RawAuthzExecMessage
+		if err := json.Unmarshal(raw, &exec); err != nil {
+			return &types.TxMessage{Type: types.TxMessageTypeUnsupported, TypeURL: rawMessage.Type}
+		}
+
+		return &types.TxMessage{
+			Type:    types.TxMessageTypeExec,
+			TypeURL: rawMessage.Type,
+			From:    exec.Grantee,
+			Messages: utils.Map(exec.Messages, func(raw json.RawMessage) *types.TxMessage {
+				return f.ParseTxMessage(raw, walletAddress)
+			}),
+		}
+	case MsgTransferTypeURL:
+		var transfer types.RawIBCTransferMessage
+		if err := json.Unmarshal(raw, &transfer); err != nil {
+			return &types.TxMessage{Type: types.TxMessageTypeUnsupported, TypeURL: rawMessage.Type}
+		}
+
+		amount, ok := math.NewIntFromString(transfer.Token.Amount)
+		if !ok {
+			return &types.TxMessage{Type: types.TxMessageTypeUnsupported, TypeURL: rawMessage.Type}
+		}
+
+		return &types.TxMessage{
+			Type:     types.TxMessageTypeIBCTransfer,
+			TypeURL:  rawMessage.Type,
+			From:     transfer.Sender,
+			To:       transfer.Receiver,
+			Channel:  transfer.SourceChannel,
+			Amounts:  []*types.Amount{types.AmountFrom(cosmosTypes.NewCoin(transfer.Token.Denom, amount))},
+			Incoming: transfer.Receiver == walletAddress,
+		}
+	case MsgRecvPacketTypeURL:
+		return f.ParseIBCRecvPacketMessage(raw, walletAddress)
+	}
+
+	msg, err := f.Converter.UnmarshalMessage(raw)
+	if err != nil {
+		f.Logger.Debug().Err(err).Str("type", rawMessage.Type).Msg("Could not decode tx message")
+		return &types.TxMessage{Type: types.TxMessageTypeUnsupported, TypeURL: rawMessage.Type}
+	}
+
+	switch typedMsg := msg.(type) {
+	case *bankTypes.MsgSend:
+		return &types.TxMessage{
+			Type:     types.TxMessageTypeSend,
+			TypeURL:  rawMessage.Type,
+			From:     typedMsg.FromAddress,
+			To:       typedMsg.ToAddress,
+			Amounts:  utils.Map(typedMsg.Amount, types.AmountFrom),
+			Incoming: typedMsg.ToAddress == walletAddress,
+		}
+	case *stakingTypes.MsgDelegate:
+		return &types.TxMessage{
+			Type:      types.TxMessageTypeDelegate,
+			TypeURL:   rawMessage.Type,
+			From:      typedMsg.DelegatorAddress,
+			Validator: typedMsg.ValidatorAddress,
+			Amounts:   []*types.Amount{types.AmountFrom(typedMsg.Amount)},
+		}
+	case *stakingTypes.MsgUndelegate:
+		return &types.TxMessage{
+			Type:      types.TxMessageTypeUndelegate,
+			TypeURL:   rawMessage.Type,
+			From:      typedMsg.DelegatorAddress,
+			Validator: typedMsg.ValidatorAddress,
+			Amounts:   []*types.Amount{types.AmountFrom(typedMsg.Amount)},
+		}
+	case *stakingTypes.MsgBeginRedelegate:
+		return &types.TxMessage{
+			Type:         types.TxMessageTypeRedelegate,
+			TypeURL:      rawMessage.Type,
+			From:         typedMsg.DelegatorAddress,
+			Validator:    typedMsg.ValidatorSrcAddress,
+			DstValidator: typedMsg.ValidatorDstAddress,
+			Amounts:      []*types.Amount{types.AmountFrom(typedMsg.Amount)},
+		}
+	case *distributionTypes.MsgWithdrawDelegatorReward:
+		return &types.TxMessage{
+			Type:      types.TxMessageTypeClaimRewards,
+			TypeURL:   rawMessage.Type,
+			From:      typedMsg.DelegatorAddress,
+			Validator: typedMsg.ValidatorAddress,
+		}
+	case *distributionTypes.MsgWithdrawValidatorCommission:
+		return &types.TxMessage{
+			Type:      types.TxMessageTypeClaimCommission,
+			TypeURL:   rawMessage.Type,
+			Validator: typedMsg.ValidatorAddress,
+		}
+	case *govV1Types.MsgVote:
+		return &types.TxMessage{
+			Type:       types.TxMessageTypeVote,
+			TypeURL:    rawMessage.Type,
+			From:       typedMsg.Voter,
+			ProposalID: typedMsg.ProposalId,
+			Option:     typedMsg.Option.String(),
+		}
+	case *govV1beta1Types.MsgVote:
+		return &types.TxMessage{
+			Type:       types.TxMessageTypeVote,
+			TypeURL:    rawMessage.Type,
+			From:       typedMsg.Voter,
+			ProposalID: typedMsg.ProposalId,
+			Option:     typedMsg.Option.String(),
+		}
+	default:
+		return &types.TxMessage{Type: types.TxMessageTypeUnsupported, TypeURL: rawMessage.Type}
+	}
+}
+
+func (f *DataFetcher) ParseIBCRecvPacketMessage(raw json.RawMessage, walletAddress string) *types.TxMessage {
+	var recvPacket types.RawIBCRecvPacketMessage
+	if err := json.Unmarshal(raw, &recvPacket); err != nil {
+		return &types.TxMessage{Type: types.TxMessageTypeUnsupported, TypeURL: MsgRecvPacketTypeURL}
+	}
+
+	packet := recvPacket.Packet
+
+	// only ICS-20 transfers are supported, other packets (like ICA) are not
+	if packet.DestinationPort != IBCTransferPort {
+		return &types.TxMessage{Type: types.TxMessageTypeUnsupported, TypeURL: MsgRecvPacketTypeURL}
+	}
+
+	var packetData types.RawFungibleTokenPacketData
+	if err := json.Unmarshal(packet.Data, &packetData); err != nil {
+		return &types.TxMessage{Type: types.TxMessageTypeUnsupported, TypeURL: MsgRecvPacketTypeURL}
+	}
+
+	amount, ok := math.NewIntFromString(packetData.Amount)
+	if !ok {
+		return &types.TxMessage{Type: types.TxMessageTypeUnsupported, TypeURL: MsgRecvPacketTypeURL}
+	}
+
+	denom := utils.GetReceivedIBCDenom(
+		packet.SourcePort,
+		packet.SourceChannel,
+		packet.DestinationPort,
+		packet.DestinationChannel,
+		packetData.Denom,
+	)
+
+	return &types.TxMessage{
+		Type:    types.TxMessageTypeIBCTransfer,
+		TypeURL: MsgRecvPacketTypeURL,
+		From:    packetData.Sender,
+		To:      packetData.Receiver,
+		Channel: packet.DestinationChannel,
+		// not using NewCoin as it panics on invalid denoms, and we cannot trust the packet data
+		Amounts:  []*types.Amount{types.AmountFrom(cosmosTypes.Coin{Denom: denom, Amount: amount})},
+		Incoming: packetData.Receiver == walletAddress,
+	}
+}
@@ -0,0 +1,132 @@
+package datafetcher
+
+import (
+	"main/pkg/types"
+	"main/pkg/utils"
+	"sort"
+	"sync"
+	"time"
+)
+
+func (f *DataFetcher) GetUpgrades(chainNames []string) types.UpgradesInfo {
+	response := types.UpgradesInfo{}
+
+	chains, err := f.Database.GetChainsByNames(chainNames)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	explorers, err := f.Database.GetExplorersByChains(chainNames)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	chainsUpgrades := map[string]*types.ChainUpgrades{}
+
+	for _, chain := range chains {
+		wg.Add(1)
+		go func(chain *types.Chain) {
+			defer wg.Done()
+
+			chainUpgrades := f.GetChainUpgrades(chain, true)
+			chainUpgrades.Explorers = explorers.GetExplorersByChain(chain.Name)
+
+			mutex.Lock()
+			chainsUpgrades[chain.Name] = chainUpgrades
+			mutex.Unlock()
+		}(chain)
+	}
+
+	wg.Wait()
+
+	response.Chains = chainsUpgrades
+	return response
+}
+
+// GetChainUpgrades returns the upcoming chain upgrades with their estimated time,
+// calculated from the latest block height and the average block time.
+// The current upgrade plan is always included, plans from passed proposals
+// only if withProposals is set, as fetching all passed proposals is expensive.
+func (f *DataFetcher) GetChainUpgrades(chain *types.Chain, withProposals bool) *types.ChainUpgrades {
+	response := &types.ChainUpgrades{Chain: chain, Upgrades: []*types.ChainUpgrade{}}
+
+	plans := make([]*types.UpgradePlan, 0)
+
+	currentPlan, err := f.NodesManager.GetCurrentUpgradePlan(chain)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	if currentPlan.Plan != nil {
+		plans = append(plans, types.UpgradePlanFrom(*currentPlan.Plan, ""))
+	}
+
+	if withProposals {
+		// The proposals are only a supplement to the current plan, so it's still shown
+		// if they cannot be fetched.
+		proposalsPlans, proposalsErr := f.NodesManager.GetPassedUpgradePlans(chain)
+		if proposalsErr != nil {
+			f.Logger.Warn().
+				Str("chain", chain.Name).
+				Err(proposalsErr).
+				Msg("Error fetching passed upgrade proposals, using only the current plan")
+		}
+
+		for _, proposalPlan := range proposalsPlans {
+			existing, found := utils.Find(plans, func(p *types.UpgradePlan) bool {
+				return p.Name == proposalPlan.Name
+			})
+
+			// The current plan usually comes from a proposal, so taking its ID.
+			if found {
+				existing.ProposalID = proposalPlan.ProposalID
+			} else {
+				plans = append(plans, proposalPlan)
+			}
+		}
+	}
+
+	block, err := f.NodesManager.GetLatestBlock(chain)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	latestHeight := block.Block.Header.Height //nolint:staticcheck
+	latestTime := block.Block.Header.Time     //nolint:staticcheck
+	response.LatestHeight = latestHeight
+
+	// Already executed plans are not interesting.
+	plans = utils.Filter(plans, func(p *types.UpgradePlan) bool {
+		return p.Height > latestHeight
+	})
+
+	if len(plans) == 0 {
+		return response
+	}
+
+	blockTime, err := f.NodesManager.GetBlockTime(chain)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].Height < plans[j].Height
+	})
+
+	for _, plan := range plans {
+		response.Upgrades = append(response.Upgrades, &types.ChainUpgrade{
+			Plan:          plan,
+			EstimatedTime: latestTime.Add(time.Duration(plan.Height-latestHeight) * blockTime),
+		})
+	}
+
+	return response
+}
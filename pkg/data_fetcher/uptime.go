@@ -0,0 +1,72 @@
+package datafetcher
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"strings"
+
+	slashingTypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (f *DataFetcher) GetValidatorUptime(chain *types.Chain, query string) types.ValidatorUptime {
+	response := types.ValidatorUptime{Chain: chain}
+
+	explorers, err := f.Database.GetExplorersByChains([]string{chain.Name})
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	response.Explorers = explorers.GetExplorersByChain(chain.Name)
+
+	validators, err := f.NodesManager.GetAllValidators(chain)
+	if err != nil {
+		response.Error = err
+		return response
+	}
+
+	// Exact address or moniker match first, then moniker substring match.
+	validator, found := utils.Find(validators.Validators, func(v stakingTypes.Validator) bool {
+		return v.OperatorAddress == query || strings.EqualFold(v.Description.Moniker, query)
+	})
+	if !found {
+		validator, found = utils.Find(validators.Validators, f.predicateByQuery(query))
+	}
+
+	if !found {
+		response.Error = constants.ErrValidatorNotFound
+		return response
+	}
+
+	response.Validator = &types.ValidatorInfo{
+		OperatorAddress: validator.OperatorAddress,
+		Jailed:          validator.Jailed,
+		Status:          validator.Status.String(),
+		Moniker:         validator.Description.Moniker,
+	}
+
+	if signingInfos, _ := f.NodesManager.GetAllSigningInfos(chain); signingInfos != nil {
+		consAddr := f.Converter.GetValidatorConsAddr(validator)
+		signingInfo, signingInfoFound := utils.Find(signingInfos.Info, func(i slashingTypes.ValidatorSigningInfo) bool {
+			equal, _ := f.Converter.CompareTwoBech32(consAddr, i.Address)
+			return equal
+		})
+
+		if signingInfoFound {
+			response.Validator.SigningInfo = &signingInfo
+		}
+	}
+
+	if slashingParams, _ := f.NodesManager.GetSlashingParams(chain); slashingParams != nil {
+		response.SlashingParams = &slashingParams.Params
+	}
+
+	response.Blocks = f.UptimeStore.GetValidatorBlocks(
+		chain.Name,
+		f.Converter.GetValidatorConsAddrHex(validator),
+	)
+
+	return response
+}
@@ -71,9 +71,11 @@ func (f *DataFetcher) FindValidatorGeneric(
 	validatorsErrors := map[string]error{}
 	signingInfosResponses := map[string]*slashingTypes.QuerySigningInfosResponse{}
 	slashingParamsResponses := map[string]*slashingTypes.QueryParamsResponse{}
+	aprs := map[string]float64{}
+	aprErrors := map[string]error{}
 
 	for _, chain := range chains {
-		wg.Add(3)
+		wg.Add(4)
 
 		go func(chain *types.Chain) {
 			defer wg.Done()
@@ -106,6 +108,16 @@ func (f *DataFetcher) FindValidatorGeneric(
 			}
 			mutex.Unlock()
 		}(chain)
+
+		go func(chain *types.Chain) {
+			defer wg.Done()
+
+			apr, aprErr := f.GetAPRCalculator(chain).GetAPR(chain)
+			mutex.Lock()
+			aprs[chain.Name] = apr
+			aprErrors[chain.Name] = aprErr
+			mutex.Unlock()
+		}(chain)
 	}
 
 	wg.Wait()
@@ -131,6 +143,8 @@ func (f *DataFetcher) FindValidatorGeneric(
 			Explorers:  explorers.GetExplorersByChain(chain.Name),
 			Error:      nil,
 			Validators: make([]types.ValidatorInfo, len(foundValidators)),
+			APR:        aprs[chain.Name],
+			APRError:   aprErrors[chain.Name],
 		}
 
 		if chainSlashingParams, ok := slashingParamsResponses[chain.Name]; ok {
@@ -0,0 +1,146 @@
+package datafetcher
+
+import (
+	"errors"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/math"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (f *DataFetcher) predicateByQueries(queries []string) func(v stakingTypes.Validator) bool {
+	predicates := utils.Map(queries, f.predicateByQuery)
+
+	return func(v stakingTypes.Validator) bool {
+		for index, predicate := range predicates {
+			if v.OperatorAddress == queries[index] || predicate(v) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// CompareValidators returns validators matching any of the queries, either by
+// operator address or by moniker substring, sorted by voting power.
+func (f *DataFetcher) CompareValidators(chain *types.Chain, queries []string) types.ValidatorsTable {
+	response := f.getValidatorsTable(chain, f.predicateByQueries(queries), types.ValidatorsSortByVotingPower)
+	if response.Error != nil {
+		return response
+	}
+
+	for _, query := range queries {
+		_, found := utils.Find(response.Info.Validators, func(v types.ValidatorInfo) bool {
+			return v.OperatorAddress == query || strings.Contains(strings.ToLower(v.Moniker), strings.ToLower(query))
+		})
+
+		if !found {
+			response.NotFound = append(response.NotFound, query)
+		}
+	}
+
+	return response
+}
+
+// GetTopValidators returns the active validators sorted by the given key.
+func (f *DataFetcher) GetTopValidators(chain *types.Chain, sortBy string) types.ValidatorsTable {
+	return f.getValidatorsTable(chain, func(v stakingTypes.Validator) bool {
+		return v.Status == stakingTypes.Bonded
+	}, sortBy)
+}
+
+func (f *DataFetcher) getValidatorsTable(
+	chain *types.Chain,
+	searchPredicate func(v stakingTypes.Validator) bool,
+	sortBy string,
+) types.ValidatorsTable {
+	response := types.ValidatorsTable{SortBy: sortBy}
+
+	validatorsInfo := f.FindValidatorGeneric([]string{chain.Name}, searchPredicate)
+	if validatorsInfo.Error != nil {
+		response.Error = validatorsInfo.Error
+		return response
+	}
+
+	chainInfo, found := validatorsInfo.Chains[chain.Name]
+	if !found {
+		response.Error = errors.New("chain is not found")
+		return response
+	}
+
+	if chainInfo.Error != nil {
+		response.Error = chainInfo.Error
+		return response
+	}
+
+	chainInfo.SortValidators(sortBy)
+
+	if len(chainInfo.Validators) > constants.ValidatorsTableMaxRows {
+		chainInfo.Validators = chainInfo.Validators[:constants.ValidatorsTableMaxRows]
+		response.Truncated = true
+	}
+
+	f.PopulateSelfDelegations(chain, chainInfo.Validators)
+
+	response.Info = chainInfo
+	return response
+}
+
+// PopulateSelfDelegations fetches how much each validator has delegated to itself,
+// leaving it empty if it could not be fetched.
+func (f *DataFetcher) PopulateSelfDelegations(chain *types.Chain, validators []types.ValidatorInfo) {
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	amounts := []*types.AmountWithChain{}
+
+	for index := range validators {
+		validator := &validators[index]
+
+		delegator, err := utils.ConvertBech32Prefix(validator.OperatorAddress, chain.Bech32AccountPrefix)
+		if err != nil {
+			f.Logger.Warn().
+				Err(err).
+				Str("chain", chain.Name).
+				Str("operator_address", validator.OperatorAddress).
+				Msg("Could not convert validator address to account address")
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(validator *types.ValidatorInfo, delegator string) {
+			defer wg.Done()
+
+			delegation, delegationErr := f.NodesManager.GetDelegation(chain, validator.OperatorAddress, delegator)
+			if delegationErr != nil {
+				f.Logger.Warn().
+					Err(delegationErr).
+					Str("chain", chain.Name).
+					Str("operator_address", validator.OperatorAddress).
+					Msg("Could not get validator self-delegation")
+				return
+			}
+
+			selfDelegation := &types.Amount{Amount: math.LegacyZeroDec(), Denom: chain.BaseDenom}
+			if delegation != nil {
+				selfDelegation = types.AmountFrom(delegation.Balance)
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			validator.SelfDelegation = selfDelegation
+			amounts = append(amounts, &types.AmountWithChain{Chain: chain.Name, Amount: selfDelegation})
+		}(validator, delegator)
+	}
+
+	wg.Wait()
+
+	f.PopulateDenoms(amounts)
+}
@@ -3,6 +3,7 @@ package datafetcher
 import (
 	"fmt"
 	"main/pkg/types"
+	"sync"
 )
 
 func (f *DataFetcher) DoesWalletExist(chain *types.Chain, wallet string) error {
@@ -17,3 +18,20 @@ func (f *DataFetcher) DoesWalletExist(chain *types.Chain, wallet string) error {
 
 	return nil
 }
+
+// CheckDerivedWalletsExist checks the wallets on all their chains at once,
+// setting the error of the ones that do not exist.
+func (f *DataFetcher) CheckDerivedWalletsExist(wallets []*types.DerivedWallet) {
+	var wg sync.WaitGroup
+
+	for _, wallet := range wallets {
+		wg.Add(1)
+		go func(wallet *types.DerivedWallet) {
+			defer wg.Done()
+
+			wallet.Error = f.DoesWalletExist(wallet.Chain, wallet.Wallet.Address)
+		}(wallet)
+	}
+
+	wg.Wait()
+}
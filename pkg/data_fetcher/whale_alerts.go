@@ -0,0 +1,51 @@
+package datafetcher
+
+import (
+	"main/pkg/types"
+
+	"cosmossdk.io/math"
+)
+
+// GetValidatorsTokensChanges returns the current validators tokens of the chain,
+// and how they changed since the previous ones, if they are set.
+// Validators missing from the previous tokens are new, so they are not reported.
+func (f *DataFetcher) GetValidatorsTokensChanges(
+	chain *types.Chain,
+	previous map[string]math.Int,
+) ([]*types.ValidatorTokensChange, map[string]math.Int, error) {
+	validators, err := f.NodesManager.GetAllValidators(chain)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current := make(map[string]math.Int, len(validators.Validators))
+	changes := make([]*types.ValidatorTokensChange, 0)
+	amounts := make([]*types.AmountWithChain, 0)
+
+	for _, validator := range validators.Validators {
+		current[validator.OperatorAddress] = validator.Tokens
+
+		previousTokens, found := previous[validator.OperatorAddress]
+		if !found || previousTokens.Equal(validator.Tokens) {
+			continue
+		}
+
+		change := &types.ValidatorTokensChange{
+			OperatorAddress: validator.OperatorAddress,
+			Moniker:         validator.Description.Moniker,
+			Previous:        previousTokens,
+			Current:         validator.Tokens,
+			Change: &types.Amount{
+				Amount: validator.Tokens.Sub(previousTokens).ToLegacyDec(),
+				Denom:  chain.BaseDenom,
+			},
+		}
+
+		changes = append(changes, change)
+		amounts = append(amounts, &types.AmountWithChain{Chain: chain.Name, Amount: change.Change})
+	}
+
+	f.PopulateDenoms(amounts)
+
+	return changes, current, nil
+}
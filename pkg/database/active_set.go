@@ -0,0 +1,61 @@
+package database
+
+// GetActiveSetWarnings returns the validators of a chain that users were warned about
+// being close to leaving the active set, and which margin has not recovered since.
+func (d *Database) GetActiveSetWarnings(chain string) ([]string, error) {
+	addresses := make([]string, 0)
+
+	rows, err := d.client.Query(
+		"SELECT address FROM active_set_warnings WHERE chain = $1",
+		chain,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error getting active set warnings")
+		return addresses, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		var address string
+
+		if err = rows.Scan(&address); err != nil {
+			d.logger.Error().Err(err).Msg("Error getting active set warning")
+			return addresses, err
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
+
+func (d *Database) InsertActiveSetWarning(chain, address string) error {
+	_, err := d.client.Exec(
+		"INSERT INTO active_set_warnings (chain, address) VALUES ($1, $2) ON CONFLICT DO NOTHING",
+		chain,
+		address,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not save active set warning")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) DeleteActiveSetWarning(chain, address string) error {
+	_, err := d.client.Exec(
+		"DELETE FROM active_set_warnings WHERE chain = $1 AND address = $2",
+		chain,
+		address,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete active set warning")
+		return err
+	}
+
+	return nil
+}
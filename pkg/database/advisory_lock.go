@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+)
+
+// AdvisoryLock is a session-level PostgreSQL advisory lock. It is held
+// as long as the dedicated connection it was acquired on is alive.
+type AdvisoryLock struct {
+	Key  int64
+	conn *sql.Conn
+}
+
+// TryAdvisoryLock tries to acquire an advisory lock without waiting,
+// returning nil if another session holds it.
+func (d *Database) TryAdvisoryLock(key int64) (*AdvisoryLock, error) {
+	ctx := context.Background()
+
+	conn, err := d.client.Conn(ctx)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not get connection to acquire advisory lock")
+		return nil, err
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&acquired); err != nil {
+		d.logger.Error().Err(err).Int64("key", key).Msg("Could not acquire advisory lock")
+		_ = conn.Close()
+		return nil, err
+	}
+
+	if !acquired {
+		_ = conn.Close()
+		return nil, nil
+	}
+
+	return &AdvisoryLock{Key: key, conn: conn}, nil
+}
+
+// CheckAdvisoryLock returns an error if the connection holding the lock is lost,
+// meaning the lock is released.
+func (d *Database) CheckAdvisoryLock(lock *AdvisoryLock) error {
+	return lock.conn.PingContext(context.Background())
+}
+
+// ReleaseAdvisoryLock releases the lock and returns its connection to the pool.
+func (d *Database) ReleaseAdvisoryLock(lock *AdvisoryLock) error {
+	ctx := context.Background()
+
+	_, err := lock.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", lock.Key)
+	if err != nil {
+		d.logger.Error().Err(err).Int64("key", lock.Key).Msg("Could not release advisory lock")
+	}
+
+	_ = lock.conn.Close()
+	return err
+}
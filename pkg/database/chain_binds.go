@@ -1,5 +1,9 @@
 package database
 
+import (
+	"main/pkg/types"
+)
+
 func (d *Database) GetAllChainBinds(chatID string) ([]string, error) {
 	chains := make([]string, 0)
 
@@ -71,3 +75,33 @@ func (d *Database) DeleteChainBind(
 	rowsAffected, _ := result.RowsAffected()
 	return rowsAffected > 0, nil
 }
+
+// GetAllChainBindsWithChats returns all chats chain binds, for notifying chats
+// about their chains events.
+func (d *Database) GetAllChainBindsWithChats() ([]*types.ChainBind, error) {
+	binds := make([]*types.ChainBind, 0)
+
+	rows, err := d.client.Query("SELECT chain, reporter, chat_id, chat_name FROM chain_binds")
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error getting all chain binds")
+		return binds, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		bind := &types.ChainBind{}
+
+		err = rows.Scan(&bind.Chain, &bind.Reporter, &bind.ChatID, &bind.ChatName)
+		if err != nil {
+			d.logger.Error().Err(err).Msg("Error getting chain bind")
+			return binds, err
+		}
+
+		binds = append(binds, bind)
+	}
+
+	return binds, nil
+}
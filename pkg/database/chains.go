@@ -14,7 +14,7 @@ func (d *Database) GetChainsByNames(names []string) ([]*types.Chain, error) {
 	chains := make([]*types.Chain, 0)
 
 	rows, err := d.client.Query(
-		"SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains WHERE name = any($1)",
+		"SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE name = any($1)",
 		pq.Array(names),
 	)
 	if err != nil {
@@ -29,7 +29,15 @@ func (d *Database) GetChainsByNames(names []string) ([]*types.Chain, error) {
 	for rows.Next() {
 		chain := &types.Chain{}
 
-		err = rows.Scan(&chain.Name, &chain.PrettyName, &chain.BaseDenom, &chain.Bech32ValidatorPrefix)
+		err = rows.Scan(
+			&chain.Name,
+			&chain.PrettyName,
+			&chain.BaseDenom,
+			&chain.Bech32ValidatorPrefix,
+			&chain.Bech32AccountPrefix,
+			&chain.CoinType,
+			&chain.MintModule,
+		)
 		if err != nil {
 			d.logger.Error().Err(err).Msg("Error getting chains by names")
 			return chains, err
@@ -44,7 +52,7 @@ func (d *Database) GetChainsByNames(names []string) ([]*types.Chain, error) {
 func (d *Database) GetChainByName(name string) (*types.Chain, error) {
 	chain := &types.Chain{}
 	row := d.client.QueryRow(
-		"SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains WHERE name = $1 LIMIT 1",
+		"SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE name = $1 LIMIT 1",
 		name,
 	)
 
@@ -53,6 +61,9 @@ func (d *Database) GetChainByName(name string) (*types.Chain, error) {
 		&chain.PrettyName,
 		&chain.BaseDenom,
 		&chain.Bech32ValidatorPrefix,
+		&chain.Bech32AccountPrefix,
+		&chain.CoinType,
+		&chain.MintModule,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -69,7 +80,7 @@ func (d *Database) GetChainByName(name string) (*types.Chain, error) {
 func (d *Database) GetAllChains() ([]*types.Chain, error) {
 	chains := make([]*types.Chain, 0)
 
-	rows, err := d.client.Query("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains")
+	rows, err := d.client.Query("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains")
 	if err != nil {
 		d.logger.Error().Err(err).Msg("Error getting all chains")
 		return chains, err
@@ -82,7 +93,15 @@ func (d *Database) GetAllChains() ([]*types.Chain, error) {
 	for rows.Next() {
 		chain := &types.Chain{}
 
-		err = rows.Scan(&chain.Name, &chain.PrettyName, &chain.BaseDenom, &chain.Bech32ValidatorPrefix)
+		err = rows.Scan(
+			&chain.Name,
+			&chain.PrettyName,
+			&chain.BaseDenom,
+			&chain.Bech32ValidatorPrefix,
+			&chain.Bech32AccountPrefix,
+			&chain.CoinType,
+			&chain.MintModule,
+		)
 		if err != nil {
 			d.logger.Error().Err(err).Msg("Error getting chain")
 			return chains, err
@@ -102,11 +121,15 @@ func (d *Database) InsertChain(chain *types.ChainWithLCD) error {
 	defer tx.Rollback() //nolint:errcheck
 
 	_, err = tx.Exec(
-		"INSERT INTO chains (name, pretty_name, base_denom, bech32_validator_prefix) VALUES ($1, $2, $3, $4)",
+		`INSERT INTO chains (name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
 		chain.Chain.Name,
 		chain.Chain.PrettyName,
 		chain.Chain.BaseDenom,
 		chain.Chain.Bech32ValidatorPrefix,
+		chain.Chain.Bech32AccountPrefix,
+		chain.Chain.CoinType,
+		chain.Chain.MintModule,
 	)
 	if err != nil {
 		d.logger.Error().Err(err).Msg("Could not insert chain")
@@ -133,10 +156,14 @@ func (d *Database) InsertChain(chain *types.ChainWithLCD) error {
 
 func (d *Database) UpdateChain(chain *types.Chain) (bool, error) {
 	result, err := d.client.Exec(
-		"UPDATE chains SET pretty_name = $1, base_denom = $2, bech32_validator_prefix = $3 WHERE name = $4",
+		`UPDATE chains SET pretty_name = $1, base_denom = $2, bech32_validator_prefix = $3,
+		bech32_account_prefix = $4, coin_type = $5, mint_module = $6 WHERE name = $7`,
 		chain.PrettyName,
 		chain.BaseDenom,
 		chain.Bech32ValidatorPrefix,
+		chain.Bech32AccountPrefix,
+		chain.CoinType,
+		chain.MintModule,
 		chain.Name,
 	)
 	if err != nil {
@@ -161,6 +188,48 @@ func (d *Database) DeleteChain(chainName string) (bool, error) {
 		return false, err
 	}
 
+	_, err = tx.Exec("DELETE FROM rpc_nodes WHERE chain = $1", chainName)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete RPC nodes when deleting chains")
+		return false, err
+	}
+
+	_, err = tx.Exec("DELETE FROM upgrade_notifications WHERE chain = $1", chainName)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete upgrade notifications when deleting chains")
+		return false, err
+	}
+
+	_, err = tx.Exec("DELETE FROM price_alerts WHERE chain = $1", chainName)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete price alerts when deleting chains")
+		return false, err
+	}
+
+	_, err = tx.Exec("DELETE FROM whale_alerts WHERE chain = $1", chainName)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete whale alerts when deleting chains")
+		return false, err
+	}
+
+	_, err = tx.Exec("DELETE FROM validators_snapshots WHERE chain = $1", chainName)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete validators snapshots when deleting chains")
+		return false, err
+	}
+
+	_, err = tx.Exec("DELETE FROM active_set_warnings WHERE chain = $1", chainName)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete active set warnings when deleting chains")
+		return false, err
+	}
+
+	_, err = tx.Exec("DELETE FROM wallets_watcher_heights WHERE chain = $1", chainName)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete wallets watcher heights when deleting chains")
+		return false, err
+	}
+
 	result, err := tx.Exec("DELETE FROM chains WHERE name = $1", chainName)
 	if err != nil {
 		d.logger.Error().Err(err).Msg("Could not delete chain")
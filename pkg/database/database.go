@@ -71,6 +71,19 @@ func (d *Database) SetClient(client *sql.DB) {
 	d.client = client
 }
 
+func (d *Database) Close() {
+	if d.client == nil {
+		return
+	}
+
+	if err := d.client.Close(); err != nil {
+		d.logger.Error().Err(err).Msg("Error closing PostgreSQL database")
+		return
+	}
+
+	d.logger.Info().Msg("PostgreSQL database closed")
+}
+
 func (d *Database) Migrate() {
 	goose.SetBaseFS(migrationsPkg.EmbedFS)
 	goose.SetLogger(d.databaseLogger)
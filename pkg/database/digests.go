@@ -0,0 +1,114 @@
+package database
+
+import (
+	"encoding/json"
+	"main/pkg/types"
+)
+
+// UpsertDigestSubscription enables the chat digest, or reschedules it.
+// The snapshot is reset, so the next digest is compared with nothing.
+func (d *Database) UpsertDigestSubscription(subscription *types.DigestSubscription) error {
+	_, err := d.client.Exec(
+		`INSERT INTO digest_subscriptions (reporter, chat_id, frequency, time, timezone, balances_user_id, last_sent_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (reporter, chat_id) DO UPDATE SET
+			frequency = $3, time = $4, timezone = $5, balances_user_id = $6, last_sent_at = $7, snapshot = NULL`,
+		subscription.Reporter,
+		subscription.ChatID,
+		subscription.Frequency,
+		subscription.Time,
+		subscription.Timezone,
+		subscription.BalancesUserID,
+		subscription.LastSentAt,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not save digest subscription")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) DeleteDigestSubscription(reporter, chatID string) (bool, error) {
+	result, err := d.client.Exec(
+		"DELETE FROM digest_subscriptions WHERE reporter = $1 AND chat_id = $2",
+		reporter,
+		chatID,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete digest subscription")
+		return false, err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+func (d *Database) GetDigestSubscriptions() ([]*types.DigestSubscription, error) {
+	subscriptions := make([]*types.DigestSubscription, 0)
+
+	rows, err := d.client.Query(
+		"SELECT reporter, chat_id, frequency, time, timezone, balances_user_id, last_sent_at, snapshot FROM digest_subscriptions",
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error getting digest subscriptions")
+		return subscriptions, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		subscription := &types.DigestSubscription{}
+
+		var snapshot []byte
+
+		err = rows.Scan(
+			&subscription.Reporter,
+			&subscription.ChatID,
+			&subscription.Frequency,
+			&subscription.Time,
+			&subscription.Timezone,
+			&subscription.BalancesUserID,
+			&subscription.LastSentAt,
+			&snapshot,
+		)
+		if err != nil {
+			d.logger.Error().Err(err).Msg("Error getting digest subscription")
+			return subscriptions, err
+		}
+
+		if snapshot != nil {
+			if err := json.Unmarshal(snapshot, &subscription.Snapshot); err != nil {
+				d.logger.Error().Err(err).Msg("Error unmarshalling digest snapshot")
+				return subscriptions, err
+			}
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, nil
+}
+
+func (d *Database) UpdateDigestSubscriptionSent(subscription *types.DigestSubscription) error {
+	snapshot, err := json.Marshal(subscription.Snapshot)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.client.Exec(
+		"UPDATE digest_subscriptions SET last_sent_at = $1, snapshot = $2 WHERE reporter = $3 AND chat_id = $4",
+		subscription.LastSentAt,
+		snapshot,
+		subscription.Reporter,
+		subscription.ChatID,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not update digest subscription")
+		return err
+	}
+
+	return nil
+}
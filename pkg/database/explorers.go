@@ -8,13 +8,14 @@ import (
 
 func (d *Database) InsertExplorer(explorer *types.Explorer) error {
 	_, err := d.client.Exec(
-		"INSERT INTO explorers (chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link) VALUES ($1, $2, $3, $4, $5, $6)",
+		"INSERT INTO explorers (chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern) VALUES ($1, $2, $3, $4, $5, $6, $7)",
 		explorer.Chain,
 		explorer.Name,
 		explorer.ProposalLinkPattern,
 		explorer.WalletLinkPattern,
 		explorer.ValidatorLinkPattern,
 		explorer.MainLink,
+		explorer.TxLinkPattern,
 	)
 	if err != nil {
 		d.logger.Error().Err(err).Msg("Could not insert explorer")
@@ -32,7 +33,7 @@ func (d *Database) GetExplorersByChains(chains []string) (types.Explorers, error
 	}
 
 	rows, err := d.client.Query(
-		"SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers WHERE chain = any($1)",
+		"SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers WHERE chain = any($1)",
 		pq.Array(chains),
 	)
 	if err != nil {
@@ -54,6 +55,7 @@ func (d *Database) GetExplorersByChains(chains []string) (types.Explorers, error
 			&explorer.WalletLinkPattern,
 			&explorer.ValidatorLinkPattern,
 			&explorer.MainLink,
+			&explorer.TxLinkPattern,
 		)
 		if err != nil {
 			d.logger.Error().Err(err).Msg("Error getting chain bind")
@@ -68,11 +70,12 @@ func (d *Database) GetExplorersByChains(chains []string) (types.Explorers, error
 
 func (d *Database) UpdateExplorer(explorer *types.Explorer) (bool, error) {
 	result, err := d.client.Exec(
-		"UPDATE explorers SET proposal_link_pattern = $1, wallet_link_pattern = $2, validator_link_pattern = $3, main_link = $4 WHERE name = $5 AND chain = $6",
+		"UPDATE explorers SET proposal_link_pattern = $1, wallet_link_pattern = $2, validator_link_pattern = $3, main_link = $4, tx_link_pattern = $5 WHERE name = $6 AND chain = $7",
 		explorer.ProposalLinkPattern,
 		explorer.WalletLinkPattern,
 		explorer.ValidatorLinkPattern,
 		explorer.MainLink,
+		explorer.TxLinkPattern,
 		explorer.Name,
 		explorer.Chain,
 	)
@@ -0,0 +1,185 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"main/pkg/types"
+
+	"github.com/lib/pq"
+)
+
+func (d *Database) GetChainBindsRecipients(chain string) ([]*types.NotificationRecipient, error) {
+	return d.getRecipients(
+		"SELECT reporter, chat_id FROM chain_binds WHERE chain = $1",
+		chain,
+	)
+}
+
+func (d *Database) GetWalletLinksRecipients(chain, address string) ([]*types.NotificationRecipient, error) {
+	return d.getRecipients(
+		"SELECT reporter, user_id FROM wallet_links WHERE chain = $1 AND address = $2",
+		chain,
+		address,
+	)
+}
+
+func (d *Database) GetValidatorLinksRecipients(chain, address string) ([]*types.NotificationRecipient, error) {
+	return d.getRecipients(
+		"SELECT reporter, user_id FROM validator_links WHERE chain = $1 AND address = $2",
+		chain,
+		address,
+	)
+}
+
+func (d *Database) getRecipients(query string, args ...any) ([]*types.NotificationRecipient, error) {
+	recipients := make([]*types.NotificationRecipient, 0)
+
+	rows, err := d.client.Query(query, args...)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error getting notification recipients")
+		return recipients, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		recipient := &types.NotificationRecipient{}
+
+		if err = rows.Scan(&recipient.Reporter, &recipient.ChatID); err != nil {
+			d.logger.Error().Err(err).Msg("Error getting notification recipient")
+			return recipients, err
+		}
+
+		recipients = append(recipients, recipient)
+	}
+
+	return recipients, nil
+}
+
+// GetNotificationSettings returns the chat notification settings,
+// or the default ones if they were never changed.
+func (d *Database) GetNotificationSettings(reporter, chatID string) (*types.NotificationSettings, error) {
+	settings := types.NewNotificationSettings(reporter, chatID)
+
+	row := d.client.QueryRow(
+		"SELECT muted_types, muted_chains, mode, timezone, quiet_hours_start, quiet_hours_end FROM notification_settings WHERE reporter = $1 AND chat_id = $2",
+		reporter,
+		chatID,
+	)
+
+	err := row.Scan(
+		pq.Array(&settings.MutedTypes),
+		pq.Array(&settings.MutedChains),
+		&settings.Mode,
+		&settings.Timezone,
+		&settings.QuietHoursStart,
+		&settings.QuietHoursEnd,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return settings, nil
+		}
+
+		d.logger.Error().Err(err).Msg("Error getting notification settings")
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (d *Database) UpsertNotificationSettings(settings *types.NotificationSettings) error {
+	_, err := d.client.Exec(
+		`INSERT INTO notification_settings (reporter, chat_id, muted_types, muted_chains, mode, timezone, quiet_hours_start, quiet_hours_end)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (reporter, chat_id) DO UPDATE SET
+			muted_types = $3, muted_chains = $4, mode = $5, timezone = $6, quiet_hours_start = $7, quiet_hours_end = $8`,
+		settings.Reporter,
+		settings.ChatID,
+		pq.Array(settings.MutedTypes),
+		pq.Array(settings.MutedChains),
+		settings.Mode,
+		settings.Timezone,
+		settings.QuietHoursStart,
+		settings.QuietHoursEnd,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not save notification settings")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) InsertQueuedNotification(notification *types.QueuedNotification) error {
+	_, err := d.client.Exec(
+		"INSERT INTO notifications_queue (reporter, chat_id, type, chain, text) VALUES ($1, $2, $3, $4, $5)",
+		notification.Reporter,
+		notification.ChatID,
+		notification.Type,
+		notification.Chain,
+		notification.Text,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not queue notification")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) GetQueuedNotifications() ([]*types.QueuedNotification, error) {
+	notifications := make([]*types.QueuedNotification, 0)
+
+	rows, err := d.client.Query(
+		"SELECT id, reporter, chat_id, type, chain, text, created_at FROM notifications_queue ORDER BY id",
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error getting queued notifications")
+		return notifications, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		notification := &types.QueuedNotification{}
+
+		err = rows.Scan(
+			&notification.ID,
+			&notification.Reporter,
+			&notification.ChatID,
+			&notification.Type,
+			&notification.Chain,
+			&notification.Text,
+			&notification.CreatedAt,
+		)
+		if err != nil {
+			d.logger.Error().Err(err).Msg("Error getting queued notification")
+			return notifications, err
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	return notifications, nil
+}
+
+// DeleteQueuedNotifications removes the chat notifications that were delivered,
+// the ones queued after lastID are kept.
+func (d *Database) DeleteQueuedNotifications(reporter, chatID string, lastID int64) error {
+	_, err := d.client.Exec(
+		"DELETE FROM notifications_queue WHERE reporter = $1 AND chat_id = $2 AND id <= $3",
+		reporter,
+		chatID,
+		lastID,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete queued notifications")
+		return err
+	}
+
+	return nil
+}
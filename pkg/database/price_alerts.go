@@ -0,0 +1,124 @@
+package database
+
+import (
+	"main/pkg/types"
+)
+
+func (d *Database) InsertPriceAlert(alert *types.PriceAlert) error {
+	row := d.client.QueryRow(
+		`INSERT INTO price_alerts (reporter, user_id, chain, denom, direction, target)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+		alert.Reporter,
+		alert.UserID,
+		alert.Chain,
+		alert.Denom,
+		alert.Direction,
+		alert.Target,
+	)
+
+	if err := row.Scan(&alert.ID); err != nil {
+		d.logger.Error().Err(err).Msg("Could not insert price alert")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) DeletePriceAlert(reporter, userID string, id int64) (bool, error) {
+	result, err := d.client.Exec(
+		"DELETE FROM price_alerts WHERE id = $1 AND reporter = $2 AND user_id = $3",
+		id,
+		reporter,
+		userID,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete price alert")
+		return false, err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+// RearmPriceAlert makes a triggered alert fire again when its target is crossed.
+func (d *Database) RearmPriceAlert(reporter, userID string, id int64) (bool, error) {
+	result, err := d.client.Exec(
+		"UPDATE price_alerts SET triggered_at = NULL WHERE id = $1 AND reporter = $2 AND user_id = $3",
+		id,
+		reporter,
+		userID,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not re-arm price alert")
+		return false, err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+func (d *Database) UpdatePriceAlertTriggered(alert *types.PriceAlert) error {
+	_, err := d.client.Exec(
+		"UPDATE price_alerts SET triggered_at = $1 WHERE id = $2",
+		alert.TriggeredAt,
+		alert.ID,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not update price alert")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) GetUserPriceAlerts(reporter, userID string) ([]*types.PriceAlert, error) {
+	return d.getPriceAlerts(
+		"SELECT id, reporter, user_id, chain, denom, direction, target, triggered_at, created_at FROM price_alerts WHERE reporter = $1 AND user_id = $2 ORDER BY id",
+		reporter,
+		userID,
+	)
+}
+
+func (d *Database) GetArmedPriceAlerts() ([]*types.PriceAlert, error) {
+	return d.getPriceAlerts(
+		"SELECT id, reporter, user_id, chain, denom, direction, target, triggered_at, created_at FROM price_alerts WHERE triggered_at IS NULL ORDER BY id",
+	)
+}
+
+func (d *Database) getPriceAlerts(query string, args ...any) ([]*types.PriceAlert, error) {
+	alerts := make([]*types.PriceAlert, 0)
+
+	rows, err := d.client.Query(query, args...)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error getting price alerts")
+		return alerts, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		alert := &types.PriceAlert{}
+
+		err = rows.Scan(
+			&alert.ID,
+			&alert.Reporter,
+			&alert.UserID,
+			&alert.Chain,
+			&alert.Denom,
+			&alert.Direction,
+			&alert.Target,
+			&alert.TriggeredAt,
+			&alert.CreatedAt,
+		)
+		if err != nil {
+			d.logger.Error().Err(err).Msg("Error getting price alert")
+			return alerts, err
+		}
+
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, nil
+}
@@ -0,0 +1,62 @@
+package database
+
+import (
+	"main/pkg/types"
+)
+
+// GetRPCNodes returns the chain CometBFT RPC nodes. Unlike LCD hosts, these are optional,
+// so an empty list is not an error.
+func (d *Database) GetRPCNodes(chain *types.Chain) ([]string, error) {
+	hosts := []string{}
+
+	rows, err := d.client.Query(
+		"SELECT host FROM rpc_nodes WHERE chain = $1",
+		chain.Name,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error getting RPC nodes for chain")
+		return hosts, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		host := ""
+
+		if scanErr := rows.Scan(&host); scanErr != nil {
+			d.logger.Error().Err(scanErr).Msg("Error getting chain RPC node")
+			return hosts, scanErr
+		}
+
+		hosts = append(hosts, host)
+	}
+
+	return hosts, nil
+}
+
+func (d *Database) InsertRPCNode(chain *types.Chain, host string) error {
+	_, err := d.client.Exec(
+		"INSERT INTO rpc_nodes (chain, host) VALUES ($1, $2)",
+		chain.Name,
+		host,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not insert RPC node")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) DeleteRPCNode(chain *types.Chain, host string) (bool, error) {
+	result, err := d.client.Exec("DELETE FROM rpc_nodes WHERE chain = $1 AND host = $2", chain.Name, host)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete RPC node")
+		return false, err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	return rowsAffected > 0, nil
+}
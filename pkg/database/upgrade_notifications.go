@@ -0,0 +1,19 @@
+package database
+
+// InsertUpgradeNotification marks the upgrade countdown notification as sent,
+// returns false if it was already sent before.
+func (d *Database) InsertUpgradeNotification(chain, name, threshold string) (bool, error) {
+	result, err := d.client.Exec(
+		"INSERT INTO upgrade_notifications (chain, name, threshold) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING",
+		chain,
+		name,
+		threshold,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not insert upgrade notification")
+		return false, err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	return rowsAffected > 0, nil
+}
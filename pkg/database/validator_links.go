@@ -73,3 +73,34 @@ func (d *Database) FindValidatorLinksByUserAndReporter(userID, reporter string)
 
 	return validatorLinks, nil
 }
+
+// GetValidatorLinksAddresses returns the validators of a chain linked by anyone.
+func (d *Database) GetValidatorLinksAddresses(chain string) ([]string, error) {
+	addresses := make([]string, 0)
+
+	rows, err := d.client.Query(
+		"SELECT DISTINCT address FROM validator_links WHERE chain = $1 ORDER BY address",
+		chain,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error getting validator links addresses")
+		return addresses, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		var address string
+
+		if err = rows.Scan(&address); err != nil {
+			d.logger.Error().Err(err).Msg("Error getting validator link address")
+			return addresses, err
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
@@ -74,3 +74,64 @@ func (d *Database) FindWalletLinksByUserAndReporter(userID, reporter string) ([]
 
 	return walletLinks, nil
 }
+
+func (d *Database) GetAllWalletLinks() ([]*types.WalletLink, error) {
+	walletLinks := make([]*types.WalletLink, 0)
+
+	rows, err := d.client.Query(
+		"SELECT chain, reporter, user_id, address, alias, notify_threshold FROM wallet_links",
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error getting all wallet links")
+		return walletLinks, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		walletLink := &types.WalletLink{}
+
+		err = rows.Scan(
+			&walletLink.Chain,
+			&walletLink.Reporter,
+			&walletLink.UserID,
+			&walletLink.Address,
+			&walletLink.Alias,
+			&walletLink.NotifyThreshold,
+		)
+		if err != nil {
+			d.logger.Error().Err(err).Msg("Error getting wallet link")
+			return walletLinks, err
+		}
+
+		walletLinks = append(walletLinks, walletLink)
+	}
+
+	return walletLinks, nil
+}
+
+func (d *Database) UpdateWalletLinkThreshold(
+	chain string,
+	reporter string,
+	address string,
+	userID string,
+	threshold float64,
+) (bool, error) {
+	result, err := d.client.Exec(
+		"UPDATE wallet_links SET notify_threshold = $1 WHERE chain = $2 AND reporter = $3 AND address = $4 AND user_id = $5",
+		threshold,
+		chain,
+		reporter,
+		address,
+		userID,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not update wallet link threshold")
+		return false, err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,49 @@
+package database
+
+// GetWalletsWatcherHeights returns the last block height processed by the wallets watcher
+// per chain. It is stored in the database so another app instance taking over the job
+// continues from it instead of notifying about the same txs again.
+func (d *Database) GetWalletsWatcherHeights() (map[string]int64, error) {
+	heights := map[string]int64{}
+
+	rows, err := d.client.Query("SELECT chain, height FROM wallets_watcher_heights")
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error getting wallets watcher heights")
+		return heights, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		var (
+			chain  string
+			height int64
+		)
+
+		if err = rows.Scan(&chain, &height); err != nil {
+			d.logger.Error().Err(err).Msg("Error getting wallets watcher height")
+			return heights, err
+		}
+
+		heights[chain] = height
+	}
+
+	return heights, nil
+}
+
+func (d *Database) SetWalletsWatcherHeight(chain string, height int64) error {
+	_, err := d.client.Exec(
+		`INSERT INTO wallets_watcher_heights (chain, height) VALUES ($1, $2)
+		ON CONFLICT (chain) DO UPDATE SET height = EXCLUDED.height, updated_at = NOW()`,
+		chain,
+		height,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not save wallets watcher height")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"main/pkg/types"
+)
+
+func (d *Database) UpsertWhaleAlertSubscription(subscription *types.WhaleAlertSubscription) error {
+	_, err := d.client.Exec(
+		`INSERT INTO whale_alerts (reporter, chat_id, chain, percent_threshold, tokens_threshold)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (reporter, chat_id, chain) DO UPDATE SET percent_threshold = $4, tokens_threshold = $5`,
+		subscription.Reporter,
+		subscription.ChatID,
+		subscription.Chain,
+		subscription.PercentThreshold,
+		subscription.TokensThreshold,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not save whale alert subscription")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) DeleteWhaleAlertSubscription(reporter, chatID, chain string) (bool, error) {
+	result, err := d.client.Exec(
+		"DELETE FROM whale_alerts WHERE reporter = $1 AND chat_id = $2 AND chain = $3",
+		reporter,
+		chatID,
+		chain,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete whale alert subscription")
+		return false, err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+// GetWhaleAlertSubscriptions returns the subscriptions of the chats
+// which still have their chain bound.
+func (d *Database) GetWhaleAlertSubscriptions() ([]*types.WhaleAlertSubscription, error) {
+	subscriptions := make([]*types.WhaleAlertSubscription, 0)
+
+	rows, err := d.client.Query(
+		`SELECT w.reporter, w.chat_id, w.chain, w.percent_threshold, w.tokens_threshold FROM whale_alerts w
+		JOIN chain_binds b ON b.reporter = w.reporter AND b.chat_id = w.chat_id AND b.chain = w.chain
+		ORDER BY w.chain, w.created_at`,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error getting whale alert subscriptions")
+		return subscriptions, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		subscription := &types.WhaleAlertSubscription{}
+
+		err = rows.Scan(
+			&subscription.Reporter,
+			&subscription.ChatID,
+			&subscription.Chain,
+			&subscription.PercentThreshold,
+			&subscription.TokensThreshold,
+		)
+		if err != nil {
+			d.logger.Error().Err(err).Msg("Error getting whale alert subscription")
+			return subscriptions, err
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, nil
+}
+
+// GetValidatorsSnapshot returns the latest validators tokens snapshot of a chain,
+// or nil if there is none yet.
+func (d *Database) GetValidatorsSnapshot(chain string) (*types.ValidatorsSnapshot, error) {
+	snapshot := &types.ValidatorsSnapshot{Chain: chain}
+
+	var tokens []byte
+
+	row := d.client.QueryRow(
+		"SELECT tokens, created_at FROM validators_snapshots WHERE chain = $1",
+		chain,
+	)
+
+	if err := row.Scan(&tokens, &snapshot.CreatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		d.logger.Error().Err(err).Msg("Error getting validators snapshot")
+		return nil, err
+	}
+
+	if err := json.Unmarshal(tokens, &snapshot.Tokens); err != nil {
+		d.logger.Error().Err(err).Msg("Error unmarshalling validators snapshot")
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+func (d *Database) UpsertValidatorsSnapshot(snapshot *types.ValidatorsSnapshot) error {
+	tokens, err := json.Marshal(snapshot.Tokens)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.client.Exec(
+		`INSERT INTO validators_snapshots (chain, tokens, created_at) VALUES ($1, $2, $3)
+		ON CONFLICT (chain) DO UPDATE SET tokens = $2, created_at = $3`,
+		snapshot.Chain,
+		tokens,
+		snapshot.CreatedAt,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not save validators snapshot")
+		return err
+	}
+
+	return nil
+}
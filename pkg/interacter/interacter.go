@@ -1,8 +1,14 @@
 package interacter
 
+import "main/pkg/types"
+
 type Interacter interface {
 	Name() string
 	Enabled() bool
 	Init()
 	Start()
+	Stop()
+	RenderNotification(event *types.NotificationEvent) (string, error)
+	RenderQueuedNotifications(notifications []*types.QueuedNotification) (string, error)
+	SendNotification(chatID string, text string) error
 }
@@ -0,0 +1,32 @@
+package telegram
+
+import (
+	"errors"
+	"main/pkg/constants"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetAccountCommand() Command {
+	return Command{
+		Name:    "account",
+		Execute: interacter.HandleAccount,
+	}
+}
+
+func (interacter *Interacter) HandleAccount(c tele.Context, chainBinds []string) (string, error) {
+	valid, usage, args := interacter.SingleChainItemParser(c.Text(), chainBinds, "address")
+	if !valid {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	chain, err := interacter.Database.GetChainByName(args.ChainName)
+	if err != nil && errors.Is(err, constants.ErrChainNotFound) {
+		return interacter.ChainNotFound()
+	} else if err != nil {
+		return "", err
+	}
+
+	accountInfo := interacter.DataFetcher.GetAccountInfo(chain, args.ItemID, interacter.Time.Now())
+	return interacter.TemplateManager.Render("account", accountInfo)
+}
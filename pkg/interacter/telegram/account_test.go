@@ -0,0 +1,747 @@
+package telegram
+
+import (
+	"errors"
+	"main/assets"
+	converterPkg "main/pkg/converter"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"main/pkg/tendermint"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+	tele "gopkg.in/telebot.v3"
+)
+
+//nolint:paralleltest // disabled
+func TestAccountInvalidInvocation(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasText("Usage: /account &lt;chain&gt; &lt;address&gt;"),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/account",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/account", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestAccountChainNotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/chain-not-found.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
+		)
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/account chain cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/account", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestAccountErrorGettingChain(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasText("Internal error!"),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
+		WillReturnError(errors.New("custom error"))
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/account chain cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/account", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestAccountErrorGettingAccount(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasText("❌ Error getting account: could not get data after 3 attempts"),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
+		)
+
+	for range 3 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/account chain cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/account", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestAccountBaseOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/auth/v1beta1/accounts/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("account-base.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("balance.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/spendable_balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("balance.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/account-base.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
+		)
+
+	for range 3 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, nil, false),
+		)
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/account chain cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/account", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestAccountUnknownTypeOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/auth/v1beta1/accounts/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("account-ethermint.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("balance.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/spendable_balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("balance.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/account-unknown.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
+		)
+
+	for range 3 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, nil, false),
+		)
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/account chain cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/account", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestAccountContinuousVestingOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/auth/v1beta1/accounts/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("account-continuous-vesting.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("balance-vesting.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/spendable_balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("spendable-balances.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/account-continuous-vesting.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
+		)
+
+	for range 3 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, nil, false),
+		)
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/account chain cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/account", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestAccountPeriodicVestingOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/auth/v1beta1/accounts/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("account-periodic-vesting.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("balance-vesting.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/spendable_balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("spendable-balances.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/account-periodic-vesting.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
+		)
+
+	for range 3 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, nil, false),
+		)
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/account chain cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/account", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestAccountModuleOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/auth/v1beta1/accounts/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("account-module.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("balance.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/spendable_balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("balance.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/account-module.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
+		)
+
+	for range 3 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, nil, false),
+		)
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/account chain cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/account", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
@@ -0,0 +1,53 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"main/pkg/constants"
+	"strconv"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetActiveSetCommand() Command {
+	return Command{
+		Name:    "active_set",
+		Execute: interacter.HandleActiveSet,
+	}
+}
+
+func (interacter *Interacter) HandleActiveSet(c tele.Context, _ []string) (string, error) {
+	valid, usage, args := interacter.SingleArgParser(c.Text(), "chain")
+	if !valid {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	chain, err := interacter.Database.GetChainByName(args.Value)
+	if err != nil && errors.Is(err, constants.ErrChainNotFound) {
+		return interacter.ChainNotFound()
+	} else if err != nil {
+		return "", err
+	}
+
+	validatorLinks, err := interacter.Database.FindValidatorLinksByUserAndReporter(
+		strconv.FormatInt(c.Sender().ID, 10),
+		interacter.Name(),
+	)
+	if err != nil {
+		return "Error getting linked validators!", err
+	}
+
+	linkedAddresses := make([]string, 0)
+	for _, link := range validatorLinks {
+		if link.Chain == chain.Name {
+			linkedAddresses = append(linkedAddresses, link.Address)
+		}
+	}
+
+	activeSet, err := interacter.DataFetcher.GetActiveSet(chain, linkedAddresses)
+	if err != nil {
+		return fmt.Sprintf("Error getting active set: %s", err), err
+	}
+
+	return interacter.TemplateManager.Render("active_set", activeSet)
+}
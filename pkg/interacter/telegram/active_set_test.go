@@ -0,0 +1,454 @@
+package telegram
+
+import (
+	"errors"
+	"main/assets"
+	converterPkg "main/pkg/converter"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"main/pkg/tendermint"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+	tele "gopkg.in/telebot.v3"
+)
+
+//nolint:paralleltest // disabled
+func TestActiveSetInvalidInvocation(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasText("Usage: /active_set &lt;chain&gt;"),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/active_set",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/active_set", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestActiveSetChainNotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/chain-not-found.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
+		)
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/active_set chain",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/active_set", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestActiveSetErrorGettingChain(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasText("Internal error!"),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
+		WillReturnError(errors.New("custom error"))
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/active_set chain",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/active_set", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestActiveSetErrorGettingLinks(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasText("Error getting linked validators!"),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
+		)
+
+	mock.ExpectQuery("SELECT chain, reporter, user_id, address FROM validator_links").
+		WillReturnError(errors.New("custom error"))
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/active_set chain",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/active_set", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestActiveSetErrorGettingValidators(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasText("Error getting active set: could not get data after 3 attempts"),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
+		)
+
+	mock.ExpectQuery("SELECT chain, reporter, user_id, address FROM validator_links").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "reporter", "user_id", "address"}).
+			AddRow("chain", "telegram", "1", "cosmosvaloper1s5kufhghcyqkpp0ps0w9kn3lmn9wp8g5lkk07e").
+			AddRow("chain", "telegram", "1", "cosmosvaloper12xx74t5sx3vgyew7es9h8e9py4ttts6zcg3vxk").
+			AddRow("another", "telegram", "1", "cosmosvaloper1qr6sk28w4r6kqsg0737wzgu05505t4glezetwn"),
+		)
+
+	for range 2 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/active_set chain",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/active_set", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestActiveSetOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/validators?pagination.limit=1000",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/params",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("staking-params.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/active-set.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
+		)
+
+	mock.ExpectQuery("SELECT chain, reporter, user_id, address FROM validator_links").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "reporter", "user_id", "address"}).
+			AddRow("chain", "telegram", "1", "cosmosvaloper1s5kufhghcyqkpp0ps0w9kn3lmn9wp8g5lkk07e").
+			AddRow("chain", "telegram", "1", "cosmosvaloper12xx74t5sx3vgyew7es9h8e9py4ttts6zcg3vxk").
+			AddRow("another", "telegram", "1", "cosmosvaloper1qr6sk28w4r6kqsg0737wzgu05505t4glezetwn"),
+		)
+
+	for range 2 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, nil, false),
+		)
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/active_set chain",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/active_set", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
@@ -0,0 +1,24 @@
+package telegram
+
+import (
+	"main/pkg/constants"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetAPRCommand() Command {
+	return Command{
+		Name:    "apr",
+		Execute: interacter.HandleAPR,
+	}
+}
+
+func (interacter *Interacter) HandleAPR(c tele.Context, chainBinds []string) (string, error) {
+	valid, usage, args := interacter.BoundChainsNoArgsParser(c.Text(), chainBinds)
+	if !valid {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	aprs := interacter.DataFetcher.GetChainsAPR(args.ChainNames)
+	return interacter.TemplateManager.Render("apr", aprs)
+}
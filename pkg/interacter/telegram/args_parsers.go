@@ -162,6 +162,53 @@ func (interacter *Interacter) SingleChainItemParser(
 	}
 }
 
+type SingleChainQuery struct {
+	ChainName string
+	Query     string
+}
+
+// Args parser when the command is called with a query that can contain spaces
+// (like validator moniker) on 1 chain (like, a validator uptime on a specific chain).
+// How it can be called:
+// - /command query - if there's exactly 1 chain bound to a chat
+// - /command chain_name query - if there's 0 or 2+ more chains bound to a chat
+
+func (interacter *Interacter) SingleChainQueryParser(
+	query string,
+	chainBinds []string,
+	argumentName string,
+) (bool, string, SingleChainQuery) {
+	if len(chainBinds) == 1 {
+		interacter.Logger.Debug().Msg("Single chain bound to a chat")
+
+		args := strings.SplitN(query, " ", 2)
+		if len(args) == 2 {
+			return true, "", SingleChainQuery{ChainName: chainBinds[0], Query: args[1]}
+		}
+
+		return false, html.EscapeString(fmt.Sprintf(
+			"Usage: %s <%s>",
+			args[0],
+			argumentName,
+		)), SingleChainQuery{}
+	}
+
+	interacter.Logger.Debug().
+		Strs("chains", chainBinds).
+		Msg("Multiple or no chain bound to a chat")
+
+	args := strings.SplitN(query, " ", 3)
+	if len(args) == 3 {
+		return true, "", SingleChainQuery{ChainName: args[1], Query: args[2]}
+	}
+
+	return false, html.EscapeString(fmt.Sprintf(
+		"Usage: %s <chain> <%s>",
+		args[0],
+		argumentName,
+	)), SingleChainQuery{}
+}
+
 type BoundChainsNoArgs struct {
 	ChainNames []string
 }
@@ -131,6 +131,40 @@ func TestSingleChainItem(t *testing.T) {
 	}, args4)
 }
 
+func TestSingleChainQuery(t *testing.T) {
+	t.Parallel()
+
+	interacter := &Interacter{
+		Logger: zerolog.Nop(),
+	}
+
+	valid1, usage1, args1 := interacter.SingleChainQueryParser("/command", []string{}, "validator")
+	require.False(t, valid1)
+	require.Equal(t, html.EscapeString("Usage: /command <chain> <validator>"), usage1)
+	require.Empty(t, args1)
+
+	valid2, usage2, args2 := interacter.SingleChainQueryParser("/command", []string{"chain"}, "validator")
+	require.False(t, valid2)
+	require.Equal(t, html.EscapeString("Usage: /command <validator>"), usage2)
+	require.Empty(t, args2)
+
+	valid3, usage3, args3 := interacter.SingleChainQueryParser("/command Quokka Stake", []string{"chain"}, "validator")
+	require.True(t, valid3)
+	require.Empty(t, usage3)
+	require.Equal(t, SingleChainQuery{
+		ChainName: "chain",
+		Query:     "Quokka Stake",
+	}, args3)
+
+	valid4, usage4, args4 := interacter.SingleChainQueryParser("/command chain1 Quokka Stake", []string{"chain1", "chain2"}, "validator")
+	require.True(t, valid4)
+	require.Empty(t, usage4)
+	require.Equal(t, SingleChainQuery{
+		ChainName: "chain1",
+		Query:     "Quokka Stake",
+	}, args4)
+}
+
 func TestBoundChainsNoArgs(t *testing.T) {
 	t.Parallel()
 
@@ -40,7 +40,7 @@ func TestTelegramBalanceErrorFetchingWallets(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -104,7 +104,7 @@ func TestTelegramBalanceErrorFetchingChains(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -119,7 +119,7 @@ func TestTelegramBalanceErrorFetchingChains(t *testing.T) {
 	mock.ExpectQuery("SELECT chain, reporter, user_id, address, alias FROM wallet_links").
 		WillReturnRows(sqlmock.NewRows([]string{"chain", "reporter", "user_id", "address", "alias"}))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -171,7 +171,7 @@ func TestTelegramBalanceErrorFetchingExplorers(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -189,12 +189,12 @@ func TestTelegramBalanceErrorFetchingExplorers(t *testing.T) {
 			AddRow("chain", "reporter", "1", "address", "alias"),
 		)
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "ustake", "chainvaloper"))
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "ustake", "chainvaloper", "chain", 118, "standard"))
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -252,7 +252,7 @@ func TestTelegramBalanceChainNotFound(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -270,13 +270,13 @@ func TestTelegramBalanceChainNotFound(t *testing.T) {
 			AddRow("otherchain", "reporter", "1", "address", "alias"),
 		)
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "ustake", "chainvaloper"))
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "ustake", "chainvaloper", "chain", 118, "standard"))
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
-		WillReturnRows(sqlmock.NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link"}))
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
+		WillReturnRows(sqlmock.NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link", "tx_link_pattern"}))
 
 	database.SetClient(db)
 
@@ -323,7 +323,7 @@ func TestTelegramBalanceNoWallets(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -338,10 +338,10 @@ func TestTelegramBalanceNoWallets(t *testing.T) {
 	mock.ExpectQuery("SELECT chain, reporter, user_id, address, alias FROM wallet_links").
 		WillReturnRows(sqlmock.NewRows([]string{"chain", "reporter", "user_id", "address", "alias"}))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "ustake", "chainvaloper"))
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "ustake", "chainvaloper", "chain", 118, "standard"))
 
 	database.SetClient(db)
 
@@ -428,11 +428,31 @@ func TestTelegramBalanceOk(t *testing.T) {
 		"https://example.com/cosmos/staking/v1beta1/validators/cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validator.json")))
 
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/mint/v1beta1/inflation",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("inflation.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/supply/by_denom?denom=uatom",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("supply-of.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/pool",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("pool.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/distribution/v1beta1/params",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("distribution-params.json")))
+
 	logger := loggerPkg.GetNopLogger()
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -452,12 +472,12 @@ func TestTelegramBalanceOk(t *testing.T) {
 			AddRow("chain", "reporter", "1", "notok", "Wrong Bech2 prefix wallet"),
 		)
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "uatom", "cosmosvaloper"))
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"))
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnRows(sqlmock.
 			NewRows([]string{
 				"chain",
@@ -466,11 +486,12 @@ func TestTelegramBalanceOk(t *testing.T) {
 				"wallet_link_pattern",
 				"validator_link_pattern",
 				"main_link",
+				"tx_link_pattern",
 			}).
-			AddRow("chain", "Ping", "", "https://example.com/wallet/%s", "https://example.com/validator/%s", ""))
+			AddRow("chain", "Ping", "", "https://example.com/wallet/%s", "https://example.com/validator/%s", "", ""))
 
-	// 3x6 per each wallet - 1 when bech32 conversion failed
-	for range 17 {
+	// 3x6 per each wallet - 1 when bech32 conversion failed, + 4 for chain APR
+	for range 21 {
 		mock.ExpectQuery("SELECT host FROM lcd").
 			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
 	}
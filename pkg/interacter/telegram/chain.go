@@ -46,10 +46,16 @@ func (interacter *Interacter) HandleChainInfo(c tele.Context, chainBinds []strin
 		return "Error getting LCD hosts!", err
 	}
 
+	rpcNodes, err := interacter.Database.GetRPCNodes(chain)
+	if err != nil {
+		return "Error getting RPC nodes!", err
+	}
+
 	return interacter.TemplateManager.Render("chain", &types.ChainInfo{
 		Chain:        chain,
 		Explorers:    explorers,
 		Denoms:       denoms,
 		LCDEndpoints: lcds,
+		RPCNodes:     rpcNodes,
 	})
 }
@@ -30,12 +30,16 @@ func (interacter *Interacter) HandleAddChain(c tele.Context, chainBinds []string
 		return "Invalid input syntax!", constants.ErrWrongInvocation
 	}
 
-	chain := types.ChainFromArgs(argsAsMap)
+	chain, err := types.ChainFromArgs(argsAsMap)
+	if err != nil {
+		return fmt.Sprintf("Invalid data provided: %s", err.Error()), err
+	}
+
 	if err := chain.Chain.Validate(); err != nil {
 		return fmt.Sprintf("Invalid data provided: %s", err.Error()), err
 	}
 
-	err := interacter.Database.InsertChain(chain)
+	err = interacter.Database.InsertChain(chain)
 	if err != nil {
 		if strings.Contains(err.Error(), "duplicate key value") {
 			return "This chain is already inserted!", err
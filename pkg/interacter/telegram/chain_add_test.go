@@ -194,6 +194,124 @@ func TestTelegramChainAddNotValid(t *testing.T) {
 	require.NoError(t, err)
 }
 
+//nolint:paralleltest // disabled
+func TestTelegramChainAddInvalidCoinType(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasText("Invalid data provided: invalid coin type: abc"),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/chain_add name=nomic coin-type=abc",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/chain_add", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestTelegramChainAddInvalidMintModule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasText("Invalid data provided: invalid mint module: abc, expected one of: standard, celestia, osmosis"),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/chain_add name=nomic mint-module=abc",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/chain_add", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
 //nolint:paralleltest // disabled
 func TestTelegramChainAddChainAlreadyExists(t *testing.T) {
 	httpmock.Activate()
@@ -246,7 +364,7 @@ func TestTelegramChainAddChainAlreadyExists(t *testing.T) {
 		ID: 1,
 		Message: &tele.Message{
 			Sender: &tele.User{Username: "testuser", ID: 1},
-			Text:   "/chain_add name=nomic lcd-endpoint=\"https://api.nomic.quokkastake.io\" pretty-name=\"Nomic\" base-denom=unom bech32-validator-prefix=nomic",
+			Text:   "/chain_add name=nomic lcd-endpoint=\"https://api.nomic.quokkastake.io\" pretty-name=\"Nomic\" base-denom=unom bech32-validator-prefix=nomic bech32-account-prefix=nomic coin-type=119",
 			Chat:   &tele.Chat{ID: 2},
 		},
 	})
@@ -310,7 +428,7 @@ func TestTelegramChainAddChainErrorInserting(t *testing.T) {
 		ID: 1,
 		Message: &tele.Message{
 			Sender: &tele.User{Username: "testuser", ID: 1},
-			Text:   "/chain_add name=nomic lcd-endpoint=\"https://api.nomic.quokkastake.io\" pretty-name=\"Nomic\" base-denom=unom bech32-validator-prefix=nomic",
+			Text:   "/chain_add name=nomic lcd-endpoint=\"https://api.nomic.quokkastake.io\" pretty-name=\"Nomic\" base-denom=unom bech32-validator-prefix=nomic bech32-account-prefix=nomic coin-type=119",
 			Chat:   &tele.Chat{ID: 2},
 		},
 	})
@@ -379,7 +497,7 @@ func TestTelegramChainAddChainOk(t *testing.T) {
 		ID: 1,
 		Message: &tele.Message{
 			Sender: &tele.User{Username: "testuser", ID: 1},
-			Text:   "/chain_add name=nomic lcd-endpoint=\"https://api.nomic.quokkastake.io\" pretty-name=\"Nomic\" base-denom=unom bech32-validator-prefix=nomic",
+			Text:   "/chain_add name=nomic lcd-endpoint=\"https://api.nomic.quokkastake.io\" pretty-name=\"Nomic\" base-denom=unom bech32-validator-prefix=nomic bech32-account-prefix=nomic coin-type=119",
 			Chat:   &tele.Chat{ID: 2},
 		},
 	})
@@ -170,6 +170,13 @@ func TestTelegramChainDeleteChainNotExisting(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec("DELETE FROM lcd").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM rpc_nodes").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM upgrade_notifications").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM price_alerts").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM whale_alerts").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM validators_snapshots").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM active_set_warnings").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM wallets_watcher_heights").WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec("DELETE FROM chains").WillReturnResult(sqlmock.NewResult(1, 0))
 	mock.ExpectCommit()
 
@@ -234,6 +241,13 @@ func TestTelegramChainDeleteOk(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec("DELETE FROM lcd").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM rpc_nodes").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM upgrade_notifications").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM price_alerts").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM whale_alerts").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM validators_snapshots").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM active_set_warnings").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM wallets_watcher_heights").WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec("DELETE FROM chains").WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
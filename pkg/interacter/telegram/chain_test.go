@@ -106,7 +106,7 @@ func TestTelegramChainErrorFindingChain(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -168,10 +168,10 @@ func TestTelegramChainErrorChainNotFoundAndFailedToFetch(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains WHERE name").
-		WillReturnRows(sqlmock.NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}))
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE name").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -233,13 +233,13 @@ func TestTelegramChainErrorChainNotFound(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains WHERE name").
-		WillReturnRows(sqlmock.NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}))
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE name").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "ustake", "chainvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "ustake", "chainvaloper", "chain", 118, "standard"),
 		)
 
 	database.SetClient(db)
@@ -301,13 +301,13 @@ func TestTelegramChainErrorFetchingExplorers(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains WHERE name =").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE name =").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chainname", "Chain", "ustake", "chainvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chainname", "Chain", "ustake", "chainvaloper", "chain", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -369,15 +369,15 @@ func TestTelegramChainErrorFetchingDenoms(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains WHERE name =").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE name =").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chainname", "Chain", "ustake", "chainvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chainname", "Chain", "ustake", "chainvaloper", "chain", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link"}),
+			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link", "tx_link_pattern"}),
 		)
 
 	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
@@ -442,14 +442,14 @@ func TestTelegramChainErrorFetchingLCDHosts(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains WHERE name =").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE name =").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chainname", "Chain", "ustake", "chainvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chainname", "Chain", "ustake", "chainvaloper", "chain", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
-		WillReturnRows(sqlmock.NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link"}))
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
+		WillReturnRows(sqlmock.NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link", "tx_link_pattern"}))
 
 	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
 		WillReturnRows(sqlmock.NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}))
@@ -486,6 +486,83 @@ func TestTelegramChainErrorFetchingLCDHosts(t *testing.T) {
 	require.NoError(t, err)
 }
 
+//nolint:paralleltest // disabled
+func TestTelegramChainErrorFetchingRPCNodes(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasText("Error getting RPC nodes!"),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE name =").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chainname", "Chain", "ustake", "chainvaloper", "chain", 118, "standard"),
+		)
+
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
+		WillReturnRows(sqlmock.NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link", "tx_link_pattern"}))
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}))
+
+	mock.ExpectQuery("SELECT host FROM lcd").
+		WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://lcd.example.com"))
+
+	mock.ExpectQuery("SELECT host FROM rpc_nodes").
+		WillReturnError(errors.New("custom error"))
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser"},
+			Text:   "/chain chainname",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/chain", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
 //nolint:paralleltest // disabled
 func TestTelegramChainOk(t *testing.T) {
 	httpmock.Activate()
@@ -516,15 +593,15 @@ func TestTelegramChainOk(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains WHERE name =").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE name =").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chainname", "Chain", "ustake", "chainvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chainname", "Chain", "ustake", "chainvaloper", "chain", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link"}).
+			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link", "tx_link_pattern"}).
 			AddRow(
 				"chainname",
 				"Ping",
@@ -532,6 +609,7 @@ func TestTelegramChainOk(t *testing.T) {
 				"https://example.com/wallets/%s",
 				"https://example.com/validators/%s",
 				"https://example.com",
+				"",
 			),
 		)
 
@@ -544,6 +622,9 @@ func TestTelegramChainOk(t *testing.T) {
 	mock.ExpectQuery("SELECT host FROM lcd").
 		WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://lcd.example.com"))
 
+	mock.ExpectQuery("SELECT host FROM rpc_nodes").
+		WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://rpc.example.com"))
+
 	database.SetClient(db)
 
 	interacter := NewInteracter(
@@ -106,7 +106,7 @@ func TestTelegramChainUnbindFetchChainError(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -168,13 +168,13 @@ func TestTelegramChainUnbindChainNotFound(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains WHERE name").
-		WillReturnRows(sqlmock.NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}))
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE name").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "uatom", "cosmosvaloper"))
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"))
 
 	database.SetClient(db)
 
@@ -235,10 +235,10 @@ func TestTelegramChainUnbindChainNotBound(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "uatom", "cosmosvaloper"))
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"))
 
 	mock.ExpectExec("DELETE FROM chain_binds").
 		WillReturnResult(sqlmock.NewResult(1, 0))
@@ -302,10 +302,10 @@ func TestTelegramChainUnbindChainErrorDeleting(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "uatom", "cosmosvaloper"))
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"))
 
 	mock.ExpectExec("DELETE FROM chain_binds").
 		WillReturnError(errors.New("custom error"))
@@ -369,10 +369,10 @@ func TestTelegramChainUnbindChainOk(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "uatom", "cosmosvaloper"))
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"))
 
 	mock.ExpectExec("DELETE FROM chain_binds").
 		WillReturnResult(sqlmock.NewResult(1, 1))
@@ -39,7 +39,10 @@ func (interacter *Interacter) HandleUpdateChain(c tele.Context, chainBinds []str
 		return fmt.Sprintf("Error fetching chain: %s", err.Error()), err
 	}
 
-	chain.UpdateFromArgs(argsAsMap)
+	if err := chain.UpdateFromArgs(argsAsMap); err != nil {
+		return fmt.Sprintf("Invalid data provided: %s", err.Error()), err
+	}
+
 	if err := chain.Validate(); err != nil {
 		return fmt.Sprintf("Invalid data provided: %s", err.Error()), err
 	}
@@ -0,0 +1,72 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+type CompareArgs struct {
+	ChainName string
+	Queries   []string
+}
+
+func (interacter *Interacter) GetCompareCommand() Command {
+	return Command{
+		Name:    "compare",
+		Execute: interacter.HandleCompare,
+	}
+}
+
+func (interacter *Interacter) HandleCompare(c tele.Context, chainBinds []string) (string, error) {
+	valid, usage, args := interacter.CompareParser(c.Text(), chainBinds)
+	if !valid {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	chain, err := interacter.Database.GetChainByName(args.ChainName)
+	if err != nil && errors.Is(err, constants.ErrChainNotFound) {
+		return interacter.ChainNotFound()
+	} else if err != nil {
+		return "", err
+	}
+
+	validatorsTable := interacter.DataFetcher.CompareValidators(chain, args.Queries)
+	return interacter.TemplateManager.Render("validators_table", validatorsTable)
+}
+
+// CompareParser parses the validators to compare, each one is either an operator
+// address or a part of a moniker, at least 2 should be passed.
+// How it can be called:
+// - /command validator1 validator2 - if there's exactly 1 chain bound to a chat
+// - /command chain_name validator1 validator2 - if there's 0 or 2+ more chains bound to a chat.
+func (interacter *Interacter) CompareParser(
+	query string,
+	chainBinds []string,
+) (bool, string, CompareArgs) {
+	args := strings.Fields(query)
+
+	if len(chainBinds) == 1 {
+		if len(args) < 3 {
+			return false, html.EscapeString(fmt.Sprintf(
+				"Usage: %s <validator1> <validator2> [validator3...]",
+				args[0],
+			)), CompareArgs{}
+		}
+
+		return true, "", CompareArgs{ChainName: chainBinds[0], Queries: args[1:]}
+	}
+
+	if len(args) < 4 {
+		return false, html.EscapeString(fmt.Sprintf(
+			"Usage: %s <chain> <validator1> <validator2> [validator3...]",
+			args[0],
+		)), CompareArgs{}
+	}
+
+	return true, "", CompareArgs{ChainName: args[1], Queries: args[2:]}
+}
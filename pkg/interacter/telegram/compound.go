@@ -0,0 +1,32 @@
+package telegram
+
+import (
+	"errors"
+	"main/pkg/constants"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetCompoundCommand() Command {
+	return Command{
+		Name:    "compound",
+		Execute: interacter.HandleCompound,
+	}
+}
+
+func (interacter *Interacter) HandleCompound(c tele.Context, chainBinds []string) (string, error) {
+	valid, usage, args := interacter.SingleChainItemParser(c.Text(), chainBinds, "address")
+	if !valid {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	chain, err := interacter.Database.GetChainByName(args.ChainName)
+	if err != nil && errors.Is(err, constants.ErrChainNotFound) {
+		return interacter.ChainNotFound()
+	} else if err != nil {
+		return "", err
+	}
+
+	compoundInfo := interacter.DataFetcher.GetCompoundInfo(chain, args.ItemID)
+	return interacter.TemplateManager.Render("compound", compoundInfo)
+}
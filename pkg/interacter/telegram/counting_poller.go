@@ -0,0 +1,63 @@
+package telegram
+
+import (
+	"sync"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+// CountingPoller wraps another poller and processes the updates it receives,
+// adding each of them to the in-flight counter before it is dispatched to a handler.
+// This way, once the bot is stopped, all the updates received are already counted,
+// and waiting for the counter cannot miss a handler that was not started yet.
+// The bot should be synchronous, so processing an update returns once it's handled.
+type CountingPoller struct {
+	Poller   tele.Poller
+	InFlight *sync.WaitGroup
+}
+
+func NewCountingPoller(poller tele.Poller, inFlight *sync.WaitGroup) *CountingPoller {
+	return &CountingPoller{
+		Poller:   poller,
+		InFlight: inFlight,
+	}
+}
+
+func (p *CountingPoller) Poll(bot *tele.Bot, _ chan tele.Update, stop chan struct{}) {
+	updates := make(chan tele.Update)
+	pollerStop := make(chan struct{})
+	pollerDone := make(chan struct{})
+
+	go func() {
+		p.Poller.Poll(bot, updates, pollerStop)
+		close(pollerDone)
+	}()
+
+	for {
+		select {
+		case update := <-updates:
+			p.Process(bot, update)
+		case <-stop:
+			close(pollerStop)
+
+			// the wrapped poller might be sending the updates it has already received
+			for {
+				select {
+				case update := <-updates:
+					p.Process(bot, update)
+				case <-pollerDone:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (p *CountingPoller) Process(bot *tele.Bot, update tele.Update) {
+	p.InFlight.Add(1)
+
+	go func() {
+		defer p.InFlight.Done()
+		bot.ProcessUpdate(update)
+	}()
+}
@@ -0,0 +1,24 @@
+package telegram
+
+import (
+	"main/pkg/constants"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetDecentralizationCommand() Command {
+	return Command{
+		Name:    "decentralization",
+		Execute: interacter.HandleDecentralization,
+	}
+}
+
+func (interacter *Interacter) HandleDecentralization(c tele.Context, chainBinds []string) (string, error) {
+	valid, usage, args := interacter.BoundChainsNoArgsParser(c.Text(), chainBinds)
+	if !valid {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	decentralization := interacter.DataFetcher.GetChainsDecentralization(args.ChainNames)
+	return interacter.TemplateManager.Render("decentralization", decentralization)
+}
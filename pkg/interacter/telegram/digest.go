@@ -0,0 +1,106 @@
+package telegram
+
+import (
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/guregu/null/v5"
+	tele "gopkg.in/telebot.v3"
+)
+
+type DigestEnableInfo struct {
+	Subscription *types.DigestSubscription
+	NextTime     time.Time
+	ChainBinds   []string
+}
+
+func (interacter *Interacter) GetDigestEnableCommand() Command {
+	return Command{
+		Name:    "digest_enable",
+		Execute: interacter.HandleDigestEnableCommand,
+	}
+}
+
+func (interacter *Interacter) GetDigestDisableCommand() Command {
+	return Command{
+		Name:    "digest_disable",
+		Execute: interacter.HandleDigestDisableCommand,
+	}
+}
+
+func (interacter *Interacter) HandleDigestEnableCommand(c tele.Context, chainBinds []string) (string, error) {
+	args := strings.Fields(c.Text())
+	usage := html.EscapeString(fmt.Sprintf(
+		"Usage: %s <daily|weekly> <HH:MM> [timezone] [balances]\n"+
+			"Weekly digests are sent on Mondays. With balances, the digest also has your wallets balance changes.",
+		args[0],
+	))
+
+	if len(args) < 3 || len(args) > 5 {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	frequency := types.DigestFrequency(args[1])
+	if frequency != types.DigestFrequencyDaily && frequency != types.DigestFrequencyWeekly {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	minutes, err := types.ParseMinutesOfDay(args[2])
+	if err != nil {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	subscription := &types.DigestSubscription{
+		Reporter:  interacter.Name(),
+		ChatID:    strconv.FormatInt(c.Chat().ID, 10),
+		Frequency: frequency,
+		Time:      minutes,
+		Timezone:  "UTC",
+		// The first digest is sent at the next scheduled time, not right away.
+		LastSentAt: interacter.Time.Now(),
+	}
+
+	for _, arg := range args[3:] {
+		if arg == "balances" {
+			subscription.BalancesUserID = null.StringFrom(strconv.FormatInt(c.Sender().ID, 10))
+			continue
+		}
+
+		if _, err := time.LoadLocation(arg); err != nil {
+			return "Invalid timezone!", err
+		}
+
+		subscription.Timezone = arg
+	}
+
+	if err := interacter.Database.UpsertDigestSubscription(subscription); err != nil {
+		return "Error saving digest settings!", err
+	}
+
+	return interacter.TemplateManager.Render("digest_enable", DigestEnableInfo{
+		Subscription: subscription,
+		NextTime:     subscription.NextScheduledTime(subscription.LastSentAt),
+		ChainBinds:   chainBinds,
+	})
+}
+
+func (interacter *Interacter) HandleDigestDisableCommand(c tele.Context, _ []string) (string, error) {
+	deleted, err := interacter.Database.DeleteDigestSubscription(
+		interacter.Name(),
+		strconv.FormatInt(c.Chat().ID, 10),
+	)
+	if err != nil {
+		return "Error disabling digest!", err
+	}
+
+	if !deleted {
+		return "Digest is not enabled for this chat!", constants.ErrDigestNotEnabled
+	}
+
+	return "✅ Digest is disabled for this chat.", nil
+}
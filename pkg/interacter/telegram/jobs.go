@@ -0,0 +1,23 @@
+package telegram
+
+import (
+	"main/pkg/types"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetJobsCommand() Command {
+	return Command{
+		Name:    "jobs",
+		Execute: interacter.HandleJobsCommand,
+	}
+}
+
+func (interacter *Interacter) HandleJobsCommand(_ tele.Context, _ []string) (string, error) {
+	jobs := []types.JobStatus{}
+	if interacter.Scheduler != nil {
+		jobs = interacter.Scheduler.GetJobsStatuses()
+	}
+
+	return interacter.TemplateManager.Render("jobs", jobs)
+}
@@ -0,0 +1,55 @@
+package telegram
+
+import (
+	"html/template"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"strconv"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+type QueuedNotificationsData struct {
+	Notifications []template.HTML
+}
+
+func (interacter *Interacter) RenderNotification(event *types.NotificationEvent) (string, error) {
+	return interacter.TemplateManager.Render(event.Template, event.Data)
+}
+
+func (interacter *Interacter) RenderQueuedNotifications(notifications []*types.QueuedNotification) (string, error) {
+	return interacter.TemplateManager.Render("notifications_queued", QueuedNotificationsData{
+		Notifications: utils.Map(notifications, func(n *types.QueuedNotification) template.HTML {
+			return template.HTML(n.Text) //nolint:gosec // rendered by us before queueing
+		}),
+	})
+}
+
+func (interacter *Interacter) SendNotification(chatID string, text string) error {
+	chatIDParsed, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	return interacter.SendMessage(chatIDParsed, text)
+}
+
+// SendMessage sends a message not as a reply to a command, but on its own,
+// like a notification in a private chat with a user.
+func (interacter *Interacter) SendMessage(chatID int64, msg string) error {
+	messages := utils.SplitStringIntoChunks(msg, MaxMessageSize)
+
+	for _, message := range messages {
+		if _, err := interacter.TelegramBot.Send(
+			&tele.Chat{ID: chatID},
+			strings.TrimSpace(message),
+			tele.ModeHTML,
+			tele.NoPreview,
+		); err != nil {
+			interacter.Logger.Error().Err(err).Int64("chat", chatID).Msg("Could not send Telegram message")
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/guregu/null/v5"
+	tele "gopkg.in/telebot.v3"
+)
+
+type NotificationsInfo struct {
+	Message  string
+	Settings *types.NotificationSettings
+}
+
+func (interacter *Interacter) GetNotificationsCommand() Command {
+	return Command{
+		Name:    "notifications",
+		Execute: interacter.HandleNotificationsCommand,
+	}
+}
+
+func (interacter *Interacter) HandleNotificationsCommand(c tele.Context, _ []string) (string, error) {
+	args := strings.Fields(c.Text())
+	usage := html.EscapeString(fmt.Sprintf(
+		"Usage:\n"+
+			"%[1]s - see notification settings\n"+
+			"%[1]s mute <type or chain>\n"+
+			"%[1]s unmute <type or chain>\n"+
+			"%[1]s mode <instant|digest>\n"+
+			"%[1]s quiet <HH:MM> <HH:MM> [timezone]\n"+
+			"%[1]s quiet off\n"+
+			"%[1]s timezone <timezone, like Europe/Berlin>\n"+
+			"Types: %[2]s",
+		args[0],
+		types.FormatNotificationTypes(),
+	))
+
+	chatID := strconv.FormatInt(c.Chat().ID, 10)
+
+	settings, err := interacter.Database.GetNotificationSettings(interacter.Name(), chatID)
+	if err != nil {
+		return "Error getting notification settings!", err
+	}
+
+	message := ""
+
+	if len(args) > 1 {
+		message, err = interacter.UpdateNotificationSettings(settings, args[1:])
+		if errors.Is(err, constants.ErrWrongInvocation) {
+			return usage, err
+		} else if err != nil {
+			return message, err
+		}
+
+		if err := interacter.Database.UpsertNotificationSettings(settings); err != nil {
+			return "Error saving notification settings!", err
+		}
+	}
+
+	return interacter.TemplateManager.Render("notifications", NotificationsInfo{
+		Message:  message,
+		Settings: settings,
+	})
+}
+
+// UpdateNotificationSettings applies the subcommand to the settings,
+// returning a message describing what was changed.
+func (interacter *Interacter) UpdateNotificationSettings(
+	settings *types.NotificationSettings,
+	args []string,
+) (string, error) {
+	switch {
+	case args[0] == "mute" && len(args) == 2:
+		if err := interacter.ValidateMuteValue(args[1]); err != nil {
+			return "Chain is not found, and it is not a notification type!", err
+		}
+
+		if !settings.Mute(args[1]) {
+			return fmt.Sprintf("%s is already muted.", args[1]), nil
+		}
+
+		return fmt.Sprintf("🔕 Muted %s.", args[1]), nil
+	case args[0] == "unmute" && len(args) == 2:
+		if !settings.Unmute(args[1]) {
+			return fmt.Sprintf("%s is not muted.", args[1]), nil
+		}
+
+		return fmt.Sprintf("🔔 Unmuted %s.", args[1]), nil
+	case args[0] == "mode" && len(args) == 2:
+		mode := types.NotificationMode(args[1])
+		if mode != types.NotificationModeInstant && mode != types.NotificationModeDigest {
+			return "", constants.ErrWrongInvocation
+		}
+
+		settings.Mode = mode
+		return fmt.Sprintf("✅ Notifications mode is set to %s.", mode), nil
+	case args[0] == "quiet" && len(args) == 2 && args[1] == "off":
+		settings.QuietHoursStart = null.Int{}
+		settings.QuietHoursEnd = null.Int{}
+		return "✅ Quiet hours are disabled.", nil
+	case args[0] == "quiet" && (len(args) == 3 || len(args) == 4):
+		start, startErr := types.ParseMinutesOfDay(args[1])
+		end, endErr := types.ParseMinutesOfDay(args[2])
+		if startErr != nil || endErr != nil {
+			return "", constants.ErrWrongInvocation
+		}
+
+		if len(args) == 4 {
+			if _, err := time.LoadLocation(args[3]); err != nil {
+				return "Invalid timezone!", err
+			}
+
+			settings.Timezone = args[3]
+		}
+
+		settings.QuietHoursStart = null.IntFrom(start)
+		settings.QuietHoursEnd = null.IntFrom(end)
+		return "✅ Quiet hours are set, notifications during them are delivered after they end.", nil
+	case args[0] == "timezone" && len(args) == 2:
+		if _, err := time.LoadLocation(args[1]); err != nil {
+			return "Invalid timezone!", err
+		}
+
+		settings.Timezone = args[1]
+		return fmt.Sprintf("✅ Timezone is set to %s.", args[1]), nil
+	default:
+		return "", constants.ErrWrongInvocation
+	}
+}
+
+// ValidateMuteValue checks that the value is either a notification type or an existing chain.
+func (interacter *Interacter) ValidateMuteValue(value string) error {
+	if types.IsNotificationType(value) {
+		return nil
+	}
+
+	_, err := interacter.Database.GetChainByName(value)
+	return err
+}
@@ -0,0 +1,619 @@
+package telegram
+
+import (
+	"errors"
+	"main/assets"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/guregu/null/v5"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:paralleltest // disabled
+func TestTelegramSendNotificationInvalidChatID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	err := interacter.SendNotification("invalid", "text")
+	require.Error(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestTelegramNotifyWalletTxOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/wallet-tx.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	priceUSD := math.LegacyMustNewDecFromStr("35.65")
+
+	text, err := interacter.RenderNotification(&types.NotificationEvent{
+		Type:     types.NotificationTypeTransfer,
+		Chain:    "chain",
+		Template: "wallet_tx",
+		Data: &types.WalletTxNotification{
+			Chain: &types.Chain{Name: "chain", PrettyName: "Chain"},
+			Explorers: types.Explorers{
+				{
+					Chain:             "chain",
+					Name:              "Ping",
+					WalletLinkPattern: "https://example.com/wallet/%s",
+					TxLinkPattern:     "https://example.com/tx/%s",
+				},
+			},
+			Wallet: &types.WalletLink{
+				Chain:    "chain",
+				Reporter: "telegram",
+				UserID:   "1",
+				Address:  "cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+				Alias:    null.StringFrom("Wallet"),
+			},
+			Tx: &types.Tx{
+				Hash:    "BBBB",
+				Height:  "180",
+				Success: true,
+				Memo:    "Thanks!",
+				Messages: []*types.TxMessage{
+					{
+						Type:     types.TxMessageTypeSend,
+						From:     "cosmos1rxvkwfw3467nxgs6r7yav6cnygkjzkkc0edu0f",
+						To:       "cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+						Incoming: true,
+						Amounts: []*types.Amount{
+							{Amount: math.LegacyNewDec(5), Denom: "ATOM", PriceUSD: &priceUSD},
+						},
+					},
+					{
+						Type:     types.TxMessageTypeIBCTransfer,
+						From:     "osmo1xqz9pemz5e5zycaa89kys5aw6m8rhgsvqlqx8y",
+						To:       "cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+						Channel:  "channel-141",
+						Incoming: true,
+						Amounts: []*types.Amount{
+							{Amount: math.LegacyNewDec(10), Denom: "OSMO"},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	err = interacter.SendNotification("1", text)
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestTelegramRenderNotificationUnknownTemplate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	_, err := interacter.RenderNotification(&types.NotificationEvent{
+		Type:     types.NotificationTypeUpgrade,
+		Chain:    "chain",
+		Template: "unknown",
+	})
+	require.Error(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestTelegramNotifyUpgradeOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/upgrade-notification.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	estimatedTime, err := time.Parse(time.RFC3339, "2025-01-19T05:42:12Z")
+	require.NoError(t, err)
+
+	text, err := interacter.RenderNotification(&types.NotificationEvent{
+		Type:     types.NotificationTypeUpgrade,
+		Chain:    "chain",
+		Template: "upgrade_notification",
+		Data: &types.UpgradeNotification{
+			Chain: &types.Chain{Name: "chain", PrettyName: "Chain"},
+			Explorers: types.Explorers{
+				{
+					Chain:               "chain",
+					Name:                "Ping",
+					ProposalLinkPattern: "https://example.com/proposals/%s",
+				},
+			},
+			Upgrade: &types.ChainUpgrade{
+				Plan: &types.UpgradePlan{
+					Name:       "v22",
+					Height:     24030000,
+					ProposalID: "985",
+				},
+				EstimatedTime: estimatedTime,
+			},
+			TimeLeft: 42 * time.Minute,
+		},
+	})
+	require.NoError(t, err)
+
+	err = interacter.SendNotification("1", text)
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestTelegramNotifyQueuedOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/notifications-queued.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		nil,
+		database,
+		metricsManager,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	text, err := interacter.RenderQueuedNotifications([]*types.QueuedNotification{
+		{ID: 1, Reporter: "telegram", ChatID: "1", Text: "🔔 <strong>Chain</strong>: first"},
+		{ID: 2, Reporter: "telegram", ChatID: "1", Text: "🔔 <strong>Chain</strong>: second"},
+	})
+	require.NoError(t, err)
+
+	err = interacter.SendNotification("1", text)
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestTelegramNotifyDigestOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/digest.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+
+	now := time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		nil,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: now},
+	)
+	interacter.Init()
+
+	price := math.LegacyNewDec(6500)
+	chain := &types.Chain{Name: "chain", PrettyName: "Chain"}
+
+	text, err := interacter.RenderNotification(&types.NotificationEvent{
+		Type:     types.NotificationTypeDigest,
+		Template: "digest",
+		Data: &types.Digest{
+			Subscription: &types.DigestSubscription{
+				Frequency:      types.DigestFrequencyWeekly,
+				BalancesUserID: null.StringFrom("1"),
+			},
+			Chains: []*types.ChainDigest{
+				{
+					Chain: chain,
+					Explorers: types.Explorers{
+						{
+							Chain:               "chain",
+							Name:                "Ping",
+							ProposalLinkPattern: "https://example.com/proposals/%s",
+						},
+					},
+					NewProposals: []*types.Proposal{
+						{ID: "987", Title: "Gaia v22 Software Upgrade", VotingEndTime: now.Add(4 * 24 * time.Hour)},
+					},
+					EndingProposals: []*types.Proposal{
+						{ID: "984", Title: "Funding Hydro", VotingEndTime: now.Add(3 * 24 * time.Hour)},
+					},
+					Supply:          &types.Amount{Amount: math.LegacyNewDec(1000), Denom: "ATOM", PriceUSD: &price},
+					SupplyChange:    types.NewDigestChange(1000, null.FloatFrom(800)),
+					BondedRatio:     types.NewDigestChange(0.6, null.FloatFrom(0.61)),
+					ActiveSetSize:   180,
+					JoinedActiveSet: []string{"Alpha", "Beta"},
+					LeftActiveSet:   []string{"Gamma"},
+					Prices: []*types.DigestPrice{
+						{Denom: "ATOM", Price: types.NewDigestChange(6.5, null.FloatFrom(5))},
+					},
+				},
+				{
+					Chain:           &types.Chain{Name: "chain2"},
+					ProposalsError:  errors.New("proposals error"),
+					SupplyError:     errors.New("supply error"),
+					PoolError:       errors.New("pool error"),
+					ValidatorsError: errors.New("validators error"),
+				},
+			},
+			Wallets: []*types.WalletDigest{
+				{
+					Chain:  chain,
+					Wallet: &types.WalletLink{Address: "cosmos1xxx", Alias: null.StringFrom("wallet")},
+					Amounts: []*types.DigestAmount{
+						{Denom: "ATOM", Amount: types.NewDigestChange(12, null.FloatFrom(10))},
+						{Denom: "OSMO", Amount: types.NewDigestChange(5, null.FloatFrom(5))},
+					},
+				},
+				{
+					Chain:  chain,
+					Wallet: &types.WalletLink{Address: "cosmos1yyy", Alias: null.StringFrom("wallet2")},
+					Error:  errors.New("balances error"),
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	err = interacter.SendNotification("1", text)
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestTelegramNotifyDigestNoChains(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/digest-no-chains.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		nil,
+		database,
+		metricsManager,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	text, err := interacter.RenderNotification(&types.NotificationEvent{
+		Type:     types.NotificationTypeDigest,
+		Template: "digest",
+		Data: &types.Digest{
+			Subscription: &types.DigestSubscription{
+				Frequency:      types.DigestFrequencyDaily,
+				BalancesUserID: null.StringFrom("1"),
+			},
+			Chains:       []*types.ChainDigest{},
+			Wallets:      []*types.WalletDigest{},
+			WalletsError: errors.New("wallets error"),
+		},
+	})
+	require.NoError(t, err)
+
+	err = interacter.SendNotification("1", text)
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestTelegramNotifyPriceAlertOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/price-alert-notification.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		nil,
+		database,
+		metricsManager,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	denom := &types.Denom{Chain: "chain", Denom: "uatom", DisplayDenom: "ATOM"}
+
+	text, err := interacter.RenderNotification(&types.NotificationEvent{
+		Type:     types.NotificationTypePriceAlert,
+		Chain:    "chain",
+		Template: "price_alert",
+		Data: types.PriceAlertNotification{
+			Alert: &types.PriceAlert{
+				ID:        1,
+				Chain:     "chain",
+				Denom:     "uatom",
+				Direction: types.PriceAlertDirectionAbove,
+				Target:    7,
+				DenomInfo: denom,
+			},
+			Price: &types.DenomPrice{Denom: denom, Price: null.FloatFrom(7.13)},
+		},
+	})
+	require.NoError(t, err)
+
+	err = interacter.SendNotification("1", text)
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestTelegramNotifyWhaleAlertOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/whale-alert.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		nil,
+		database,
+		metricsManager,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	priceUSD := math.LegacyNewDec(-713000)
+
+	text, err := interacter.RenderNotification(&types.NotificationEvent{
+		Type:     types.NotificationTypeWhale,
+		Chain:    "chain",
+		Template: "whale_alert",
+		Data: &types.WhaleAlert{
+			Chain: &types.Chain{Name: "chain", PrettyName: "Chain"},
+			Explorers: types.Explorers{
+				{
+					Chain:                "chain",
+					Name:                 "Ping",
+					ValidatorLinkPattern: "https://example.com/validators/%s",
+				},
+			},
+			Since: time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC),
+			Changes: []*types.ValidatorTokensChange{
+				{
+					OperatorAddress: "cosmosvaloper1xxx",
+					Moniker:         "Validator 1",
+					Previous:        math.NewInt(1000000000000),
+					Current:         math.NewInt(1100000000000),
+					Change:          &types.Amount{Amount: math.LegacyNewDec(100000), Denom: "ATOM"},
+				},
+				{
+					OperatorAddress: "cosmosvaloper1yyy",
+					Moniker:         "Validator 2",
+					Previous:        math.NewInt(2000000000000),
+					Current:         math.NewInt(1900000000000),
+					Change:          &types.Amount{Amount: math.LegacyNewDec(-100000), Denom: "ATOM", PriceUSD: &priceUSD},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	err = interacter.SendNotification("1", text)
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestTelegramNotifyActiveSetWarningOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/active-set-warning.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		nil,
+		database,
+		metricsManager,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	text, err := interacter.RenderNotification(&types.NotificationEvent{
+		Type:             types.NotificationTypeActiveSet,
+		Chain:            "chain",
+		ValidatorAddress: "cosmosvaloper1xxx",
+		Template:         "active_set_warning",
+		Data: &types.ActiveSetWarning{
+			Chain: &types.Chain{Name: "chain", PrettyName: "Chain"},
+			Explorers: types.Explorers{
+				{
+					Chain:                "chain",
+					Name:                 "Ping",
+					ValidatorLinkPattern: "https://example.com/validators/%s",
+				},
+			},
+			MaxValidators: 180,
+			Validator: &types.ActiveSetValidator{
+				OperatorAddress: "cosmosvaloper1xxx",
+				Moniker:         "Validator",
+				Rank:            179,
+				Active:          true,
+				Tokens:          &types.Amount{Amount: math.LegacyNewDec(50000), Denom: "ATOM"},
+				Margin:          &types.Amount{Amount: math.LegacyNewDec(1000), Denom: "ATOM"},
+				MarginPercent:   0.02,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	err = interacter.SendNotification("1", text)
+	require.NoError(t, err)
+}
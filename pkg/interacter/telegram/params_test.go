@@ -39,7 +39,7 @@ func TestParamsInvalidInvocation(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -99,7 +99,7 @@ func TestParamsErrorFetchingChains(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -111,7 +111,7 @@ func TestParamsErrorFetchingChains(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -163,7 +163,7 @@ func TestParamsAllFail(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -175,13 +175,13 @@ func TestParamsAllFail(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "uatom", "cosmosvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
 		)
 
-	for range 8 {
+	for range 10 {
 		mock.ExpectQuery("SELECT host FROM lcd").
 			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
 	}
@@ -236,6 +236,11 @@ func TestParamsOk(t *testing.T) {
 		"https://example.com/cosmos/slashing/v1beta1/params",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("slashing-params.json")))
 
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/validators?pagination.limit=1000",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators.json")))
+
 	httpmock.RegisterResponder(
 		"GET",
 		"https://example.com/cosmos/staking/v1beta1/params",
@@ -280,7 +285,7 @@ func TestParamsOk(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -292,13 +297,13 @@ func TestParamsOk(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "uatom", "cosmosvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
 		)
 
-	for range 8 {
+	for range 10 {
 		mock.ExpectQuery("SELECT host FROM lcd").
 			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
 	}
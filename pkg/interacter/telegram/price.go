@@ -0,0 +1,42 @@
+package telegram
+
+import (
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetPriceCommand() Command {
+	return Command{
+		Name:    "price",
+		Execute: interacter.HandlePrice,
+	}
+}
+
+func (interacter *Interacter) HandlePrice(c tele.Context, chainBinds []string) (string, error) {
+	args := strings.Fields(c.Text())
+	usage := html.EscapeString(fmt.Sprintf("Usage: %s [chain1,chain2] [denom]", args[0]))
+
+	var (
+		chainNames []string
+		denomName  string
+	)
+
+	switch {
+	case len(args) == 1 && len(chainBinds) > 0:
+		chainNames = chainBinds
+	case len(args) == 2:
+		chainNames = strings.Split(args[1], ",")
+	case len(args) == 3:
+		chainNames = strings.Split(args[1], ",")
+		denomName = args[2]
+	default:
+		return usage, constants.ErrWrongInvocation
+	}
+
+	prices := interacter.DataFetcher.GetPrices(chainNames, denomName)
+	return interacter.TemplateManager.Render("price", prices)
+}
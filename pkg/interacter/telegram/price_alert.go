@@ -0,0 +1,183 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"strconv"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetPriceAlertCommand() Command {
+	return Command{
+		Name:    "price_alert",
+		Execute: interacter.HandlePriceAlert,
+	}
+}
+
+func (interacter *Interacter) GetPriceAlertsCommand() Command {
+	return Command{
+		Name:    "price_alerts",
+		Execute: interacter.HandlePriceAlerts,
+	}
+}
+
+func (interacter *Interacter) GetPriceAlertRearmCommand() Command {
+	return Command{
+		Name:    "price_alert_rearm",
+		Execute: interacter.HandlePriceAlertRearm,
+	}
+}
+
+func (interacter *Interacter) GetPriceAlertDeleteCommand() Command {
+	return Command{
+		Name:    "price_alert_delete",
+		Execute: interacter.HandlePriceAlertDelete,
+	}
+}
+
+func (interacter *Interacter) HandlePriceAlert(c tele.Context, _ []string) (string, error) {
+	args := strings.Fields(c.Text())
+	usage := html.EscapeString(fmt.Sprintf("Usage: %s <chain> <denom> <above|below> <usd>", args[0]))
+
+	if len(args) != 5 {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	direction := types.PriceAlertDirection(args[3])
+	if direction != types.PriceAlertDirectionAbove && direction != types.PriceAlertDirectionBelow {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	target, err := strconv.ParseFloat(args[4], 64)
+	if err != nil || target <= 0 {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	chain, err := interacter.Database.GetChainByName(args[1])
+	if err != nil && errors.Is(err, constants.ErrChainNotFound) {
+		return interacter.ChainNotFound()
+	} else if err != nil {
+		return "", err
+	}
+
+	denoms, err := interacter.Database.GetDenomsByChain(chain)
+	if err != nil {
+		return "Error getting denoms!", err
+	}
+
+	var denom *types.Denom
+	for _, chainDenom := range denoms {
+		if chainDenom.Denom == args[2] || chainDenom.DisplayDenom == args[2] {
+			denom = chainDenom
+			break
+		}
+	}
+
+	if denom == nil || denom.CoingeckoCurrency.IsZero() {
+		return "Denom is not found or has no price source!", constants.ErrDenomNotFound
+	}
+
+	alert := &types.PriceAlert{
+		Reporter:  interacter.Name(),
+		UserID:    strconv.FormatInt(c.Sender().ID, 10),
+		Chain:     chain.Name,
+		Denom:     denom.Denom,
+		Direction: direction,
+		Target:    target,
+		DenomInfo: denom,
+	}
+
+	if err := interacter.Database.InsertPriceAlert(alert); err != nil {
+		return "Error saving price alert!", err
+	}
+
+	return interacter.TemplateManager.Render("price_alert_add", alert)
+}
+
+func (interacter *Interacter) HandlePriceAlerts(c tele.Context, _ []string) (string, error) {
+	alerts, err := interacter.Database.GetUserPriceAlerts(
+		interacter.Name(),
+		strconv.FormatInt(c.Sender().ID, 10),
+	)
+	if err != nil {
+		return "Error getting price alerts!", err
+	}
+
+	chainWithDenoms := make([]types.ChainWithDenom, len(alerts))
+	for index, alert := range alerts {
+		chainWithDenoms[index] = types.ChainWithDenom{Chain: alert.Chain, Denom: alert.Denom}
+	}
+
+	denoms, err := interacter.Database.FindDenoms(chainWithDenoms)
+	if err != nil {
+		return "Error getting denoms!", err
+	}
+
+	denomsMap := denoms.ToMap()
+	for _, alert := range alerts {
+		if chainDenoms, ok := denomsMap[alert.Chain]; ok {
+			alert.DenomInfo = chainDenoms[alert.Denom]
+		}
+	}
+
+	return interacter.TemplateManager.Render("price_alerts", alerts)
+}
+
+func (interacter *Interacter) HandlePriceAlertRearm(c tele.Context, _ []string) (string, error) {
+	args := strings.Fields(c.Text())
+	if len(args) != 2 {
+		return html.EscapeString(fmt.Sprintf("Usage: %s <ID>", args[0])), constants.ErrWrongInvocation
+	}
+
+	id, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return html.EscapeString(fmt.Sprintf("Usage: %s <ID>", args[0])), constants.ErrWrongInvocation
+	}
+
+	rearmed, err := interacter.Database.RearmPriceAlert(
+		interacter.Name(),
+		strconv.FormatInt(c.Sender().ID, 10),
+		id,
+	)
+	if err != nil {
+		return "Error re-arming price alert!", err
+	}
+
+	if !rearmed {
+		return "Price alert is not found!", constants.ErrPriceAlertNotFound
+	}
+
+	return "✅ Price alert is re-armed.", nil
+}
+
+func (interacter *Interacter) HandlePriceAlertDelete(c tele.Context, _ []string) (string, error) {
+	args := strings.Fields(c.Text())
+	if len(args) != 2 {
+		return html.EscapeString(fmt.Sprintf("Usage: %s <ID>", args[0])), constants.ErrWrongInvocation
+	}
+
+	id, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return html.EscapeString(fmt.Sprintf("Usage: %s <ID>", args[0])), constants.ErrWrongInvocation
+	}
+
+	deleted, err := interacter.Database.DeletePriceAlert(
+		interacter.Name(),
+		strconv.FormatInt(c.Sender().ID, 10),
+		id,
+	)
+	if err != nil {
+		return "Error deleting price alert!", err
+	}
+
+	if !deleted {
+		return "Price alert is not found!", constants.ErrPriceAlertNotFound
+	}
+
+	return "✅ Price alert is deleted.", nil
+}
@@ -3,6 +3,7 @@ package telegram
 import (
 	"errors"
 	"main/pkg/constants"
+	"strconv"
 
 	tele "gopkg.in/telebot.v3"
 )
@@ -31,5 +32,14 @@ func (interacter *Interacter) HandleSingleProposal(
 	}
 
 	proposalInfo := interacter.DataFetcher.GetSingleProposal(chain, args.ItemID)
+	if proposalInfo.Error == nil && proposalInfo.Proposal != nil {
+		proposalInfo.WalletsVotes, proposalInfo.WalletsVotesError = interacter.DataFetcher.GetProposalWalletsVotes(
+			chain,
+			args.ItemID,
+			strconv.FormatInt(c.Sender().ID, 10),
+			interacter.Name(),
+		)
+	}
+
 	return interacter.TemplateManager.Render("proposal", proposalInfo)
 }
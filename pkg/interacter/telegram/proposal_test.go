@@ -109,13 +109,13 @@ func TestProposalSingleChainNotFound(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains WHERE").
-		WillReturnRows(sqlmock.NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}))
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "uatom", "cosmosvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
 		)
 
 	database.SetClient(db)
@@ -177,7 +177,7 @@ func TestProposalSingleErrorFetchingChain(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains WHERE").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains WHERE").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -239,13 +239,13 @@ func TestProposalSingleErrorFetchingExplorers(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "uatom", "cosmosvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -302,7 +302,7 @@ func TestProposalSingleErrorFetchingProposal(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -314,16 +314,16 @@ func TestProposalSingleErrorFetchingProposal(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "uatom", "cosmosvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link"}).
-			AddRow("chain", "Ping", "https://example.com/proposal/{id}", "", "", ""))
+			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link", "tx_link_pattern"}).
+			AddRow("chain", "Ping", "https://example.com/proposal/{id}", "", "", "", ""))
 
 	mock.ExpectQuery("SELECT host FROM lcd").
 		WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
@@ -378,11 +378,26 @@ func TestProposalSingleOk(t *testing.T) {
 		"https://example.com/cosmos/gov/v1/proposals/123",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("proposal.json")))
 
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/gov/v1/proposals/123/tally",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("proposal-tally.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/pool",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("pool.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/gov/v1beta1/params/tallying",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-params-tallying.json")))
+
 	logger := loggerPkg.GetNopLogger()
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -394,19 +409,296 @@ func TestProposalSingleOk(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "uatom", "cosmosvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link"}).
-			AddRow("chain", "Ping", "https://example.com/proposal/%s", "", "", ""))
+			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link", "tx_link_pattern"}).
+			AddRow("chain", "Ping", "https://example.com/proposal/%s", "", "", "", ""))
 
-	mock.ExpectQuery("SELECT host FROM lcd").
-		WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	for range 4 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, nil, false),
+		)
+
+	mock.ExpectQuery("SELECT chain, reporter, user_id, address, alias FROM wallet_links").
+		WillReturnRows(sqlmock.NewRows([]string{"chain", "reporter", "user_id", "address", "alias"}))
+
+	database.SetClient(db)
+
+	renderTime, err := time.Parse(time.RFC3339, "2023-11-17T21:00:27.879790211Z")
+	require.NoError(t, err)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: renderTime},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/proposal chain 123",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/proposal", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestProposalSingleWithWalletsVotes(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/proposal-wallets-votes.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/gov/v1/proposals/123",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("proposal-voting.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/gov/v1/proposals/123/tally",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("proposal-tally.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/pool",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("pool.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/gov/v1beta1/params/tallying",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-params-tallying.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/delegations/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2?pagination.limit=1000",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("delegations-proposal-votes.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/gov/v1/proposals/123/votes/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("proposal-vote-not-found.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/gov/v1/proposals/123/votes/cosmos1qphf0ferqcch0jca9hlqfm3x0eds3dpkac4g9j",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("proposal-vote-yes.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/gov/v1/proposals/123/votes/cosmos1qr6sk28w4r6kqsg0737wzgu05505t4glukd7zq",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("proposal-vote-not-found.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/validators/cosmosvaloper1qphf0ferqcch0jca9hlqfm3x0eds3dpkcvpafp",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validator-alpha.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/validators/cosmosvaloper1qr6sk28w4r6kqsg0737wzgu05505t4glezetwn",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validator-beta.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
+		)
+
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link", "tx_link_pattern"}).
+			AddRow("chain", "Ping", "https://example.com/proposal/%s", "", "", "", ""))
+
+	for range 4 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, nil, false),
+		)
+
+	mock.ExpectQuery("SELECT chain, reporter, user_id, address, alias FROM wallet_links").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "reporter", "user_id", "address", "alias"}).
+			AddRow("chain", "telegram", "1", "cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2", "wallet"),
+		)
+
+	for range 4 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	database.SetClient(db)
+
+	renderTime, err := time.Parse(time.RFC3339, "2023-11-17T21:00:27.879790211Z")
+	require.NoError(t, err)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		dataFetcher,
+		database,
+		metricsManager,
+		&timePkg.StubTime{NowTime: renderTime},
+	)
+	interacter.Init()
+
+	ctx := interacter.TelegramBot.NewContext(tele.Update{
+		ID: 1,
+		Message: &tele.Message{
+			Sender: &tele.User{Username: "testuser", ID: 1},
+			Text:   "/proposal chain 123",
+			Chat:   &tele.Chat{ID: 2},
+		},
+	})
+
+	err = interacter.TelegramBot.Trigger("/proposal", ctx)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestProposalSingleV1beta1Ok(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasBytes(assets.GetBytesOrPanic("responses/proposal-v1beta1.html")),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/gov/v1/proposals/123",
+		httpmock.NewBytesResponder(501, assets.GetBytesOrPanic("lcd-not-implemented.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/gov/v1beta1/proposals/123",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("proposal-v1beta1.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/gov/v1/proposals/123/tally",
+		httpmock.NewBytesResponder(501, assets.GetBytesOrPanic("lcd-not-implemented.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/gov/v1beta1/proposals/123/tally",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("proposal-tally-v1beta1.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/pool",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("pool.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/gov/v1beta1/params/tallying",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-params-tallying.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain1").AddRow("chain2"))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
+		)
+
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link", "tx_link_pattern"}).
+			AddRow("chain", "Ping", "https://example.com/proposal/%s", "", "", "", ""))
+
+	for range 4 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, nil, false),
+		)
+
+	mock.ExpectQuery("SELECT chain, reporter, user_id, address, alias FROM wallet_links").
+		WillReturnRows(sqlmock.NewRows([]string{"chain", "reporter", "user_id", "address", "alias"}))
 
 	database.SetClient(db)
 
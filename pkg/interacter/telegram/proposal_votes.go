@@ -0,0 +1,35 @@
+package telegram
+
+import (
+	"errors"
+	"main/pkg/constants"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetProposalVotesCommand() Command {
+	return Command{
+		Name:    "proposal_votes",
+		Execute: interacter.HandleProposalVotes,
+	}
+}
+
+func (interacter *Interacter) HandleProposalVotes(
+	c tele.Context,
+	chainBinds []string,
+) (string, error) {
+	valid, usage, args := interacter.SingleChainItemParser(c.Text(), chainBinds, "proposal ID")
+	if !valid {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	chain, err := interacter.Database.GetChainByName(args.ChainName)
+	if err != nil && errors.Is(err, constants.ErrChainNotFound) {
+		return interacter.ChainNotFound()
+	} else if err != nil {
+		return "", err
+	}
+
+	votesInfo := interacter.DataFetcher.GetProposalVotes(chain, args.ItemID)
+	return interacter.TemplateManager.Render("proposal_votes", votesInfo)
+}
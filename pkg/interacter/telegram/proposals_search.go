@@ -0,0 +1,110 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"strconv"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+type ProposalsSearchArgs struct {
+	ChainName string
+	Status    string
+	Query     string
+	Page      int
+}
+
+func (interacter *Interacter) GetProposalsSearchCommand() Command {
+	return Command{
+		Name:    "proposals_search",
+		Execute: interacter.HandleProposalsSearch,
+	}
+}
+
+func (interacter *Interacter) HandleProposalsSearch(c tele.Context, chainBinds []string) (string, error) {
+	valid, usage, args := interacter.ProposalsSearchParser(c.Text(), chainBinds)
+	if !valid {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	chain, err := interacter.Database.GetChainByName(args.ChainName)
+	if err != nil && errors.Is(err, constants.ErrChainNotFound) {
+		return interacter.ChainNotFound()
+	} else if err != nil {
+		return "", err
+	}
+
+	proposals := interacter.DataFetcher.SearchProposals(chain, args.Status, args.Query, args.Page)
+	return interacter.TemplateManager.Render("proposals_search", proposals)
+}
+
+// ProposalsSearchParser parses the search args, status=X and page=N can be anywhere,
+// everything else is the search text.
+// How it can be called:
+// - /command [status=passed] [page=2] [text] - if there's exactly 1 chain bound to a chat
+// - /command chain_name [status=passed] [page=2] [text] - if there's 0 or 2+ more chains bound to a chat.
+func (interacter *Interacter) ProposalsSearchParser(
+	query string,
+	chainBinds []string,
+) (bool, string, ProposalsSearchArgs) {
+	args := strings.Fields(query)
+	statuses := "passed|rejected|deposit|voting|all"
+
+	usage := html.EscapeString(fmt.Sprintf(
+		"Usage: %s <chain> [status=%s] [page=N] [text]",
+		args[0],
+		statuses,
+	))
+	if len(chainBinds) == 1 {
+		usage = html.EscapeString(fmt.Sprintf(
+			"Usage: %s [status=%s] [page=N] [text]",
+			args[0],
+			statuses,
+		))
+	}
+
+	parsed := ProposalsSearchArgs{Status: "all", Page: 1}
+	textArgs := make([]string, 0)
+
+	for _, arg := range args[1:] {
+		if status, ok := strings.CutPrefix(arg, "status="); ok {
+			if _, found := types.ProposalStatusFilters[status]; !found {
+				return false, usage, ProposalsSearchArgs{}
+			}
+
+			parsed.Status = status
+			continue
+		}
+
+		if page, ok := strings.CutPrefix(arg, "page="); ok {
+			pageParsed, err := strconv.Atoi(page)
+			if err != nil || pageParsed <= 0 {
+				return false, usage, ProposalsSearchArgs{}
+			}
+
+			parsed.Page = pageParsed
+			continue
+		}
+
+		textArgs = append(textArgs, arg)
+	}
+
+	if len(chainBinds) == 1 {
+		parsed.ChainName = chainBinds[0]
+	} else {
+		if len(textArgs) == 0 {
+			return false, usage, ProposalsSearchArgs{}
+		}
+
+		parsed.ChainName = textArgs[0]
+		textArgs = textArgs[1:]
+	}
+
+	parsed.Query = strings.Join(textArgs, " ")
+	return true, "", parsed
+}
@@ -108,7 +108,7 @@ func TestProposalActiveErrorFetchingChains(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -170,13 +170,13 @@ func TestProposalActiveErrorFetchingExplorers(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix FROM chains"}).
-			AddRow("chain1", "Chain 1", "uatom", "cosmosvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain1", "Chain 1", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -226,14 +226,14 @@ func TestProposalActiveQueryError(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://example.com/cosmos/gov/v1/proposals?pagination.limit=1000&proposal_status=PROPOSAL_STATUS_VOTING_PERIOD",
+		"https://example.com/cosmos/gov/v1/proposals?proposal_status=PROPOSAL_STATUS_VOTING_PERIOD&pagination.limit=1000",
 		httpmock.NewErrorResponder(errors.New("custom error")))
 
 	logger := loggerPkg.GetNopLogger()
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -245,16 +245,16 @@ func TestProposalActiveQueryError(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix FROM chains"}).
-			AddRow("chain1", "Chain 1", "uatom", "cosmosvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain1", "Chain 1", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link"}).
-			AddRow("chain1", "Ping", "https://example.com/proposals/%s", "", "", ""),
+			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link", "tx_link_pattern"}).
+			AddRow("chain1", "Ping", "https://example.com/proposals/%s", "", "", "", ""),
 		)
 
 	mock.ExpectQuery("SELECT host FROM lcd").
@@ -311,14 +311,14 @@ func TestProposalActiveOk(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://example.com/cosmos/gov/v1/proposals?pagination.limit=1000&proposal_status=PROPOSAL_STATUS_VOTING_PERIOD",
+		"https://example.com/cosmos/gov/v1/proposals?proposal_status=PROPOSAL_STATUS_VOTING_PERIOD&pagination.limit=1000",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("proposals-active.json")))
 
 	logger := loggerPkg.GetNopLogger()
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -330,16 +330,16 @@ func TestProposalActiveOk(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain").AddRow("chain2"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix FROM chains"}).
-			AddRow("chain1", "Chain 1", "uatom", "cosmosvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain1", "Chain 1", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link"}).
-			AddRow("chain1", "Ping", "https://example.com/proposals/%s", "", "", ""),
+			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link", "tx_link_pattern"}).
+			AddRow("chain1", "Ping", "https://example.com/proposals/%s", "", "", "", ""),
 		)
 
 	mock.ExpectQuery("SELECT host FROM lcd").
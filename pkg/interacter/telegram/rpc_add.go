@@ -0,0 +1,37 @@
+package telegram
+
+import (
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetRPCAddCommand() Command {
+	return Command{
+		Name:    "rpc_add",
+		Execute: interacter.HandleAddRPCNode,
+	}
+}
+
+func (interacter *Interacter) HandleAddRPCNode(c tele.Context, chainBinds []string) (string, error) {
+	args := strings.SplitN(c.Text(), " ", 3)
+	if len(args) < 3 {
+		return html.EscapeString(fmt.Sprintf("Usage: %s <chain name> <host>", args[0])), constants.ErrWrongInvocation
+	}
+
+	chainName, host := args[1], args[2]
+	chain, err := interacter.Database.GetChainByName(chainName)
+	if err != nil {
+		return "Error finding chain!", err
+	}
+
+	if insertErr := interacter.Database.InsertRPCNode(chain, host); insertErr != nil {
+		return "Error inserting RPC node!", insertErr
+	}
+
+	return interacter.TemplateManager.Render("rpc_add", types.ChainWithRPCNode{Chain: *chain, RPCNode: host})
+}
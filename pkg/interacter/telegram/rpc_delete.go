@@ -0,0 +1,45 @@
+package telegram
+
+import (
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetRPCDeleteCommand() Command {
+	return Command{
+		Name:    "rpc_delete",
+		Execute: interacter.HandleDeleteRPCNode,
+	}
+}
+
+func (interacter *Interacter) HandleDeleteRPCNode(c tele.Context, chainBinds []string) (string, error) {
+	args := strings.SplitN(c.Text(), " ", 3)
+	if len(args) < 3 {
+		return html.EscapeString(fmt.Sprintf("Usage: %s <chain name> <host>", args[0])), constants.ErrWrongInvocation
+	}
+
+	chainName, host := args[1], args[2]
+	chain, err := interacter.Database.GetChainByName(chainName)
+	if err != nil {
+		return "Error finding chain!", err
+	}
+
+	deleted, deleteErr := interacter.Database.DeleteRPCNode(chain, host)
+	if deleteErr != nil {
+		return "Error deleting RPC node!", deleteErr
+	}
+
+	if !deleted {
+		return "Chain RPC node was not found!", constants.ErrRPCNodeNotFound
+	}
+
+	return interacter.TemplateManager.Render("rpc_delete", types.ChainWithRPCNode{
+		Chain:   *chain,
+		RPCNode: host,
+	})
+}
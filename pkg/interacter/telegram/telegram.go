@@ -4,11 +4,13 @@ import (
 	datafetcher "main/pkg/data_fetcher"
 	databasePkg "main/pkg/database"
 	"main/pkg/metrics"
+	"main/pkg/scheduler"
 	"main/pkg/templates"
 	timePkg "main/pkg/time"
 	"main/pkg/types"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"gopkg.in/telebot.v3/middleware"
@@ -20,8 +22,9 @@ import (
 )
 
 type Interacter struct {
-	Token  string
-	Admins []int64
+	Token   string
+	Admins  []int64
+	Webhook types.TelegramWebhookConfig
 
 	Version string
 
@@ -32,8 +35,12 @@ type Interacter struct {
 	Chains          types.Chains
 	TemplateManager templates.Manager
 	MetricsManager  *metrics.Manager
+	Scheduler       *scheduler.Scheduler
+	Time            timePkg.Time
 
-	StopChannel chan bool
+	// Updates being processed, so the shutdown can wait
+	// until the replies to commands are sent.
+	CommandsInFlight sync.WaitGroup
 }
 
 const (
@@ -52,13 +59,14 @@ func NewInteracter(
 	return &Interacter{
 		Token:           config.Token,
 		Admins:          config.Admins,
+		Webhook:         config.Webhook,
 		Logger:          logger.With().Str("component", "telegram_interacter").Logger(),
 		Version:         version,
 		DataFetcher:     dataFetcher,
 		Database:        database,
 		TemplateManager: templates.NewTelegramTemplatesManager(logger, time),
 		MetricsManager:  metricsManager,
-		StopChannel:     make(chan bool),
+		Time:            time,
 	}
 }
 
@@ -68,9 +76,17 @@ func (interacter *Interacter) Init() {
 		return
 	}
 
+	var poller tele.Poller = &tele.LongPoller{Timeout: 10 * time.Second}
+	if interacter.Webhook.Enabled.Bool {
+		interacter.Logger.Debug().Msg("Using webhook to receive updates")
+		poller = NewWebhookPoller(interacter.Webhook, interacter.Logger)
+	}
+
+	// updates are processed in parallel by the poller, which counts them as in flight
 	bot, err := tele.NewBot(tele.Settings{
-		Token:  interacter.Token,
-		Poller: &tele.LongPoller{Timeout: 10 * time.Second},
+		Token:       interacter.Token,
+		Poller:      NewCountingPoller(poller, &interacter.CommandsInFlight),
+		Synchronous: true,
 	})
 	if err != nil {
 		interacter.Logger.Panic().Err(err).Msg("Could not create Telegram bot")
@@ -80,18 +96,42 @@ func (interacter *Interacter) Init() {
 	interacter.AddCommand("/help", bot, interacter.GetHelpCommand())
 	interacter.AddCommand("/validator", bot, interacter.GetValidatorCommand())
 	interacter.AddCommand("/validators", bot, interacter.GetValidatorsCommand())
+	interacter.AddCommand("/uptime", bot, interacter.GetUptimeCommand())
+	interacter.AddCommand("/compare", bot, interacter.GetCompareCommand())
+	interacter.AddCommand("/top", bot, interacter.GetTopCommand())
 	interacter.AddCommand("/params", bot, interacter.GetParamsCommand())
+	interacter.AddCommand("/decentralization", bot, interacter.GetDecentralizationCommand())
 	interacter.AddCommand("/proposal", bot, interacter.GetSingleProposalCommand())
+	interacter.AddCommand("/proposal_votes", bot, interacter.GetProposalVotesCommand())
 	interacter.AddCommand("/proposals", bot, interacter.GetActiveProposalsCommand())
+	interacter.AddCommand("/proposals_search", bot, interacter.GetProposalsSearchCommand())
+	interacter.AddCommand("/upgrades", bot, interacter.GetUpgradesCommand())
 	interacter.AddCommand("/wallet_link", bot, interacter.GetWalletLinkCommand())
+	interacter.AddCommand("/wallet_link_all", bot, interacter.GetWalletLinkAllCommand())
 	interacter.AddCommand("/wallet_unlink", bot, interacter.GetWalletUnlinkCommand())
+	interacter.AddCommand("/wallet_threshold", bot, interacter.GetWalletThresholdCommand())
 	interacter.AddCommand("/validator_link", bot, interacter.GetValidatorLinkCommand())
 	interacter.AddCommand("/validator_unlink", bot, interacter.GetValidatorUnlinkCommand())
 	interacter.AddCommand("/wallets", bot, interacter.GetWalletsCommand())
 	interacter.AddCommand("/chains", bot, interacter.GetChainsListCommand())
 	interacter.AddCommand("/chain", bot, interacter.GetChainInfoCommand())
 	interacter.AddCommand("/balance", bot, interacter.GetBalanceCommand())
+	interacter.AddCommand("/txs", bot, interacter.GetTxsCommand())
 	interacter.AddCommand("/supply", bot, interacter.GetSupplyCommand())
+	interacter.AddCommand("/apr", bot, interacter.GetAPRCommand())
+	interacter.AddCommand("/price", bot, interacter.GetPriceCommand())
+	interacter.AddCommand("/compound", bot, interacter.GetCompoundCommand())
+	interacter.AddCommand("/account", bot, interacter.GetAccountCommand())
+	interacter.AddCommand("/notifications", bot, interacter.GetNotificationsCommand())
+	interacter.AddCommand("/digest_enable", bot, interacter.GetDigestEnableCommand())
+	interacter.AddCommand("/digest_disable", bot, interacter.GetDigestDisableCommand())
+	interacter.AddCommand("/price_alert", bot, interacter.GetPriceAlertCommand())
+	interacter.AddCommand("/price_alerts", bot, interacter.GetPriceAlertsCommand())
+	interacter.AddCommand("/price_alert_rearm", bot, interacter.GetPriceAlertRearmCommand())
+	interacter.AddCommand("/price_alert_delete", bot, interacter.GetPriceAlertDeleteCommand())
+	interacter.AddCommand("/whale_alert", bot, interacter.GetWhaleAlertCommand())
+	interacter.AddCommand("/whale_alert_disable", bot, interacter.GetWhaleAlertDisableCommand())
+	interacter.AddCommand("/active_set", bot, interacter.GetActiveSetCommand())
 
 	if len(interacter.Admins) > 0 {
 		interacter.Logger.Debug().Msg("Using admins whitelist")
@@ -109,6 +149,9 @@ func (interacter *Interacter) Init() {
 	interacter.AddCommand("/denom_delete", bot, interacter.GetDenomDeleteCommand())
 	interacter.AddCommand("/lcd_add", bot, interacter.GetLCDAddCommand())
 	interacter.AddCommand("/lcd_delete", bot, interacter.GetLCDDeleteCommand())
+	interacter.AddCommand("/rpc_add", bot, interacter.GetRPCAddCommand())
+	interacter.AddCommand("/rpc_delete", bot, interacter.GetRPCDeleteCommand())
+	interacter.AddCommand("/jobs", bot, interacter.GetJobsCommand())
 
 	interacter.TelegramBot = bot
 }
@@ -164,11 +207,7 @@ func (interacter *Interacter) AddCommand(query string, bot *tele.Bot, command Co
 }
 
 func (interacter *Interacter) Start() {
-	go interacter.TelegramBot.Start()
-
-	<-interacter.StopChannel
-	interacter.Logger.Info().Msg("Shutting down...")
-	interacter.TelegramBot.Stop()
+	interacter.TelegramBot.Start()
 }
 
 func (interacter *Interacter) Enabled() bool {
@@ -179,8 +218,22 @@ func (interacter *Interacter) Name() string {
 	return "telegram"
 }
 
+// Stop stops receiving new updates, then waits for the commands
+// that are being processed to send their replies.
 func (interacter *Interacter) Stop() {
-	interacter.StopChannel <- true
+	interacter.Logger.Info().Msg("Shutting down...")
+	interacter.TelegramBot.Stop()
+
+	if interacter.Webhook.Enabled.Bool && interacter.Webhook.RemoveOnStop.Bool {
+		if err := interacter.TelegramBot.RemoveWebhook(); err != nil {
+			interacter.Logger.Error().Err(err).Msg("Could not remove Telegram webhook")
+		} else {
+			interacter.Logger.Info().Msg("Telegram webhook is removed")
+		}
+	}
+
+	interacter.CommandsInFlight.Wait()
+	interacter.Logger.Info().Msg("All commands are processed")
 }
 
 func (interacter *Interacter) BotReply(c tele.Context, msg string) error {
@@ -10,6 +10,7 @@ import (
 	"main/pkg/types"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 
@@ -19,6 +20,21 @@ import (
 	"github.com/jarcoal/httpmock"
 )
 
+// StubPoller sends the given updates once, and then waits for the bot to be stopped.
+type StubPoller struct {
+	Updates []tele.Update
+	Sent    chan struct{}
+}
+
+func (p *StubPoller) Poll(bot *tele.Bot, updates chan tele.Update, stop chan struct{}) {
+	for _, update := range p.Updates {
+		updates <- update
+	}
+
+	close(p.Sent)
+	<-stop
+}
+
 func TestTelegramInitNoTokenProvided(t *testing.T) {
 	t.Parallel()
 
@@ -276,3 +292,77 @@ func TestTelegramAddCommandFailedToFetchChains(t *testing.T) {
 	err = interacter.TelegramBot.Trigger("/help", ctx)
 	require.NoError(t, err)
 }
+
+//nolint:paralleltest // disabled
+func TestTelegramStopWaitsForCommandsInFlight(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterMatcherResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/sendMessage",
+		types.TelegramResponseHasText("Done!"),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-send-message-ok.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectExec("INSERT INTO queries").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	database.SetClient(db)
+
+	interacter := NewInteracter(
+		types.TelegramConfig{Token: "xxx:yyy", Admins: []int64{1, 2}},
+		"v1.2.3",
+		logger,
+		nil,
+		database,
+		metricsManager,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	poller := &StubPoller{
+		Updates: []tele.Update{{
+			ID: 1,
+			Message: &tele.Message{
+				Sender: &tele.User{Username: "testuser", ID: 1},
+				Text:   "/slow",
+				Chat:   &tele.Chat{ID: 2},
+			},
+		}},
+		Sent: make(chan struct{}),
+	}
+	interacter.TelegramBot.Poller = NewCountingPoller(poller, &interacter.CommandsInFlight)
+
+	interacter.AddCommand("/slow", interacter.TelegramBot, Command{
+		Name: "slow",
+		Execute: func(c tele.Context, chainBinds []string) (string, error) {
+			time.Sleep(200 * time.Millisecond)
+			return "Done!", nil
+		},
+	})
+
+	go interacter.Start()
+
+	// stopping right after the update is received, while the command is still processed
+	<-poller.Sent
+	interacter.Stop()
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	// getMe and the reply to the command
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,86 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"slices"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+type TopArgs struct {
+	ChainName string
+	SortBy    string
+}
+
+func (interacter *Interacter) GetTopCommand() Command {
+	return Command{
+		Name:    "top",
+		Execute: interacter.HandleTop,
+	}
+}
+
+func (interacter *Interacter) HandleTop(c tele.Context, chainBinds []string) (string, error) {
+	valid, usage, args := interacter.TopParser(c.Text(), chainBinds)
+	if !valid {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	chain, err := interacter.Database.GetChainByName(args.ChainName)
+	if err != nil && errors.Is(err, constants.ErrChainNotFound) {
+		return interacter.ChainNotFound()
+	} else if err != nil {
+		return "", err
+	}
+
+	validatorsTable := interacter.DataFetcher.GetTopValidators(chain, args.SortBy)
+	return interacter.TemplateManager.Render("validators_table", validatorsTable)
+}
+
+// TopParser parses the chain and the sorting key, by=X can be anywhere.
+// How it can be called:
+// - /command [by=vp|commission|uptime] - if there's exactly 1 chain bound to a chat
+// - /command chain_name [by=vp|commission|uptime] - if there's 0 or 2+ more chains bound to a chat.
+func (interacter *Interacter) TopParser(
+	query string,
+	chainBinds []string,
+) (bool, string, TopArgs) {
+	args := strings.Fields(query)
+	sortOptions := strings.Join(types.ValidatorsSortOptions, "|")
+
+	usage := html.EscapeString(fmt.Sprintf("Usage: %s <chain> [by=%s]", args[0], sortOptions))
+	if len(chainBinds) == 1 {
+		usage = html.EscapeString(fmt.Sprintf("Usage: %s [chain] [by=%s]", args[0], sortOptions))
+	}
+
+	parsed := TopArgs{SortBy: types.ValidatorsSortByVotingPower}
+	otherArgs := make([]string, 0)
+
+	for _, arg := range args[1:] {
+		if sortBy, ok := strings.CutPrefix(arg, "by="); ok {
+			if !slices.Contains(types.ValidatorsSortOptions, sortBy) {
+				return false, usage, TopArgs{}
+			}
+
+			parsed.SortBy = sortBy
+			continue
+		}
+
+		otherArgs = append(otherArgs, arg)
+	}
+
+	switch {
+	case len(otherArgs) == 1:
+		parsed.ChainName = otherArgs[0]
+	case len(otherArgs) == 0 && len(chainBinds) == 1:
+		parsed.ChainName = chainBinds[0]
+	default:
+		return false, usage, TopArgs{}
+	}
+
+	return true, "", parsed
+}
@@ -0,0 +1,47 @@
+package telegram
+
+import (
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"strconv"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetTxsCommand() Command {
+	return Command{
+		Name:    "txs",
+		Execute: interacter.HandleTxsCommand,
+	}
+}
+
+func (interacter *Interacter) HandleTxsCommand(c tele.Context, chainBinds []string) (string, error) {
+	args := strings.Split(c.Text(), " ")
+	usage := html.EscapeString(fmt.Sprintf("Usage: %s [wallet alias] [limit]", args[0]))
+
+	// the last argument is a limit if it's a number, everything else is an alias,
+	// which might contain spaces
+	args = args[1:]
+	limit := constants.TxsDefaultLimit
+
+	if len(args) > 0 {
+		if parsedLimit, err := strconv.Atoi(args[len(args)-1]); err == nil {
+			limit = parsedLimit
+			args = args[:len(args)-1]
+		}
+	}
+
+	if limit <= 0 || limit > constants.TxsMaxLimit {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	txs := interacter.DataFetcher.GetWalletsTxs(
+		strconv.FormatInt(c.Sender().ID, 10),
+		interacter.Name(),
+		strings.Join(args, " "),
+		limit,
+	)
+	return interacter.TemplateManager.Render("txs", txs)
+}
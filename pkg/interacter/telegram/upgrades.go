@@ -0,0 +1,24 @@
+package telegram
+
+import (
+	"main/pkg/constants"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetUpgradesCommand() Command {
+	return Command{
+		Name:    "upgrades",
+		Execute: interacter.HandleUpgrades,
+	}
+}
+
+func (interacter *Interacter) HandleUpgrades(c tele.Context, chainBinds []string) (string, error) {
+	valid, usage, args := interacter.BoundChainsNoArgsParser(c.Text(), chainBinds)
+	if !valid {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	upgradesInfo := interacter.DataFetcher.GetUpgrades(args.ChainNames)
+	return interacter.TemplateManager.Render("upgrades", upgradesInfo)
+}
@@ -0,0 +1,32 @@
+package telegram
+
+import (
+	"errors"
+	"main/pkg/constants"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetUptimeCommand() Command {
+	return Command{
+		Name:    "uptime",
+		Execute: interacter.HandleUptime,
+	}
+}
+
+func (interacter *Interacter) HandleUptime(c tele.Context, chainBinds []string) (string, error) {
+	valid, usage, args := interacter.SingleChainQueryParser(c.Text(), chainBinds, "validator")
+	if !valid {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	chain, err := interacter.Database.GetChainByName(args.ChainName)
+	if err != nil && errors.Is(err, constants.ErrChainNotFound) {
+		return interacter.ChainNotFound()
+	} else if err != nil {
+		return "", err
+	}
+
+	uptimeInfo := interacter.DataFetcher.GetValidatorUptime(chain, args.Query)
+	return interacter.TemplateManager.Render("uptime", uptimeInfo)
+}
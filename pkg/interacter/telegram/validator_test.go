@@ -39,7 +39,7 @@ func TestValidatorSearchInvalidInvocation(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -99,7 +99,7 @@ func TestValidatorSearchErrorFetchingChain(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -111,7 +111,7 @@ func TestValidatorSearchErrorFetchingChain(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -163,7 +163,7 @@ func TestValidatorSearchErrorFetchingExplorers(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -175,13 +175,13 @@ func TestValidatorSearchErrorFetchingExplorers(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "uatom", "cosmosvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -231,7 +231,7 @@ func TestValidatorSearchNotFound(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://example.com/cosmos/staking/v1beta1/validators?pagination.count_total=true&pagination.limit=1000",
+		"https://example.com/cosmos/staking/v1beta1/validators?pagination.limit=1000",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators.json")))
 
 	httpmock.RegisterResponder(
@@ -248,7 +248,7 @@ func TestValidatorSearchNotFound(t *testing.T) {
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -260,13 +260,13 @@ func TestValidatorSearchNotFound(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "uatom", "cosmosvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnRows(sqlmock.
 			NewRows([]string{"chain",
 				"name",
@@ -274,10 +274,11 @@ func TestValidatorSearchNotFound(t *testing.T) {
 				"wallet_link_pattern",
 				"validator_link_pattern",
 				"main_link",
-			}).AddRow("chain", "Ping", "", "", "https://example.com/validators/%s", ""),
+				"tx_link_pattern",
+			}).AddRow("chain", "Ping", "", "", "https://example.com/validators/%s", "", ""),
 		)
 
-	for range 3 {
+	for range 7 {
 		mock.ExpectQuery("SELECT host FROM lcd").
 			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
 	}
@@ -329,7 +330,7 @@ func TestValidatorSearchOk(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://example.com/cosmos/staking/v1beta1/validators?pagination.count_total=true&pagination.limit=1000",
+		"https://example.com/cosmos/staking/v1beta1/validators?pagination.limit=1000",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators.json")))
 
 	httpmock.RegisterResponder(
@@ -347,11 +348,31 @@ func TestValidatorSearchOk(t *testing.T) {
 		"https://api.coingecko.com/api/v3/simple/price?ids=cosmos&vs_currencies=usd",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("coingecko.json")))
 
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/mint/v1beta1/inflation",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("inflation.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/supply/by_denom?denom=uatom",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("supply-of.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/pool",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("pool.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/distribution/v1beta1/params",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("distribution-params.json")))
+
 	logger := loggerPkg.GetNopLogger()
 	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
 	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
 	converter := converterPkg.NewConverter()
-	nodesManager := tendermint.NewNodeManager(logger, database, converter, metricsManager)
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
 	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
 
 	db, mock, err := sqlmock.New()
@@ -363,13 +384,13 @@ func TestValidatorSearchOk(t *testing.T) {
 	mock.ExpectQuery("SELECT chain FROM chain_binds").
 		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain", "Chain", "uatom", "cosmosvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnRows(sqlmock.
 			NewRows([]string{"chain",
 				"name",
@@ -377,10 +398,11 @@ func TestValidatorSearchOk(t *testing.T) {
 				"wallet_link_pattern",
 				"validator_link_pattern",
 				"main_link",
-			}).AddRow("chain", "Ping", "", "", "https://example.com/validators/%s", ""),
+				"tx_link_pattern",
+			}).AddRow("chain", "Ping", "", "", "https://example.com/validators/%s", "", ""),
 		)
 
-	for range 3 {
+	for range 7 {
 		mock.ExpectQuery("SELECT host FROM lcd").
 			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
 	}
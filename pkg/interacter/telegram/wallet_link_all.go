@@ -0,0 +1,117 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"strconv"
+	"strings"
+
+	"github.com/guregu/null/v5"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetWalletLinkAllCommand() Command {
+	return Command{
+		Name:    "wallet_link_all",
+		Execute: interacter.HandleWalletLinkAllCommand,
+	}
+}
+
+func (interacter *Interacter) HandleWalletLinkAllCommand(c tele.Context, chainBinds []string) (string, error) {
+	args := strings.SplitN(c.Text(), " ", 3)
+	if len(args) < 3 {
+		return html.EscapeString(fmt.Sprintf("Usage: %s <address> <alias>", args[0])), constants.ErrWrongInvocation
+	}
+
+	address, alias := args[1], args[2]
+
+	chains, err := interacter.Database.GetAllChains()
+	if err != nil {
+		return "", err
+	}
+
+	wallets := make([]*types.DerivedWallet, 0)
+
+	for _, chain := range chains {
+		if chain.CoinType != constants.CosmosCoinType {
+			continue
+		}
+
+		// chains added before account prefixes were stored might not have it set yet
+		if chain.Bech32AccountPrefix == "" {
+			interacter.Logger.Warn().
+				Str("chain", chain.Name).
+				Msg("Chain has no bech32 account prefix set, not linking wallet on it")
+			continue
+		}
+
+		chainAddress, convertErr := utils.ConvertBech32Prefix(address, chain.Bech32AccountPrefix)
+		if convertErr != nil {
+			return fmt.Sprintf("Invalid address: %s", convertErr), convertErr
+		}
+
+		wallets = append(wallets, &types.DerivedWallet{
+			Chain: chain,
+			Wallet: &types.WalletLink{
+				Chain:    chain.Name,
+				Reporter: interacter.Name(),
+				UserID:   strconv.FormatInt(c.Sender().ID, 10),
+				Address:  chainAddress,
+				Alias:    null.StringFrom(alias),
+			},
+		})
+	}
+
+	if len(wallets) == 0 {
+		return fmt.Sprintf("There are no chains with the coin type %d!", constants.CosmosCoinType), nil
+	}
+
+	interacter.DataFetcher.CheckDerivedWalletsExist(wallets)
+
+	chainNames := make([]string, 0)
+
+	for _, wallet := range wallets {
+		if wallet.Error != nil {
+			continue
+		}
+
+		chainNames = append(chainNames, wallet.Chain.Name)
+
+		// not stopping at the first failed chain, as the wallets on the previous ones
+		// are already linked, so the user can see where it succeeded and where it did not
+		if err := interacter.Database.InsertWalletLink(wallet.Wallet); err != nil {
+			if strings.Contains(err.Error(), "duplicate key value") {
+				wallet.AlreadyLinked = true
+				continue
+			}
+
+			interacter.Logger.Error().
+				Err(err).
+				Str("chain", wallet.Chain.Name).
+				Msg("Error inserting wallet link")
+			wallet.Error = errors.New("could not save the wallet link")
+		}
+	}
+
+	// the wallets are linked already, so showing them without the explorer links
+	// instead of an error
+	explorers, err := interacter.Database.GetExplorersByChains(chainNames)
+	if err != nil {
+		interacter.Logger.Error().Err(err).Msg("Error fetching explorers")
+	}
+
+	for _, wallet := range wallets {
+		wallet.Explorers = explorers.GetExplorersByChain(wallet.Chain.Name)
+	}
+
+	return interacter.TemplateManager.Render("wallet_link_all", types.DerivedWallets{
+		Address: address,
+		Alias:   alias,
+		Wallets: wallets,
+	})
+}
@@ -0,0 +1,56 @@
+package telegram
+
+import (
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"strconv"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetWalletThresholdCommand() Command {
+	return Command{
+		Name:    "wallet_threshold",
+		Execute: interacter.HandleWalletThreshold,
+	}
+}
+
+func (interacter *Interacter) HandleWalletThreshold(c tele.Context, chainBinds []string) (string, error) {
+	args := strings.Split(c.Text(), " ")
+	usage := html.EscapeString(fmt.Sprintf("Usage: %s <chain name> <address> <min amount>", args[0]))
+
+	if len(args) < 4 {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	threshold, err := strconv.ParseFloat(args[3], 64)
+	if err != nil || threshold < 0 {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	updated, err := interacter.Database.UpdateWalletLinkThreshold(
+		args[1],
+		interacter.Name(),
+		args[2],
+		strconv.FormatInt(c.Sender().ID, 10),
+		threshold,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if !updated {
+		return "Wallet was not linked!", nil
+	}
+
+	if threshold == 0 {
+		return "You will be notified about all transfers of this wallet.", nil
+	}
+
+	return fmt.Sprintf(
+		"You will be notified about transfers of this wallet of at least %s tokens.",
+		strconv.FormatFloat(threshold, 'f', -1, 64),
+	), nil
+}
@@ -113,7 +113,7 @@ func TestTelegramWalletsErrorFetchingChains(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"chain", "reporter", "user_id", "address", "alias"}).
 			AddRow("chain1", "reporter1", 1, "address1", "alias1"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -179,13 +179,13 @@ func TestTelegramWalletsErrorFetchingExplorers(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"chain", "reporter", "user_id", "address", "alias"}).
 			AddRow("chain1", "reporter1", 1, "address1", "alias1"))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain1", "Chain 1", "uatom", "cosmosvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain1", "Chain 1", "uatom", "cosmosvaloper", "cosmos", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnError(errors.New("custom error"))
 
 	database.SetClient(db)
@@ -250,8 +250,8 @@ func TestTelegramWalletsErrorNoChains(t *testing.T) {
 	mock.ExpectQuery("SELECT chain, reporter, user_id, address, alias FROM wallet_links").
 		WillReturnRows(sqlmock.NewRows([]string{"chain", "reporter", "user_id", "address", "alias"}))
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
-		WillReturnRows(sqlmock.NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}))
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}))
 
 	database.SetClient(db)
 
@@ -318,18 +318,18 @@ func TestTelegramWalletsOk(t *testing.T) {
 			AddRow("chain2", "telegram", 1, "address1", "alias1"),
 		)
 
-	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix FROM chains").
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix"}).
-			AddRow("chain1", "Chain 1", "uatom", "cosmosvaloper").
-			AddRow("chain2", "Chain 2", "ustake", "cosmosvaloper"),
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain1", "Chain 1", "uatom", "cosmosvaloper", "cosmos", 118, "standard").
+			AddRow("chain2", "Chain 2", "ustake", "cosmosvaloper", "cosmos", 118, "standard"),
 		)
 
-	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link FROM explorers").
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
 		WillReturnRows(sqlmock.
-			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link"}).
-			AddRow("chain1", "Ping", "", "https://example.com/wallets/%s", "", "").
-			AddRow("chain2", "Ping", "", "https://example2.com/wallets/%s", "", ""),
+			NewRows([]string{"chain", "name", "proposal_link_pattern", "wallet_link_pattern", "validator_link_pattern", "main_link", "tx_link_pattern"}).
+			AddRow("chain1", "Ping", "", "https://example.com/wallets/%s", "", "", "").
+			AddRow("chain2", "Ping", "", "https://example2.com/wallets/%s", "", "", ""),
 		)
 
 	database.SetClient(db)
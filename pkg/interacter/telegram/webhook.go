@@ -0,0 +1,122 @@
+package telegram
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"net/http"
+
+	"github.com/rs/zerolog"
+	tele "gopkg.in/telebot.v3"
+)
+
+// WebhookPoller receives Telegram updates via a webhook. It is used instead
+// of tele.Webhook, which closes the stop channel already closed by the bot,
+// panicking when the bot is stopped.
+type WebhookPoller struct {
+	Config  types.TelegramWebhookConfig
+	Webhook *tele.Webhook
+	Logger  zerolog.Logger
+
+	updates chan tele.Update
+	stop    chan struct{}
+}
+
+func NewWebhookPoller(config types.TelegramWebhookConfig, logger zerolog.Logger) *WebhookPoller {
+	webhook := &tele.Webhook{
+		Listen:      config.ListenAddr,
+		SecretToken: config.SecretToken,
+		Endpoint: &tele.WebhookEndpoint{
+			PublicURL: config.PublicURL,
+			Cert:      config.PublicCert,
+		},
+	}
+
+	return &WebhookPoller{
+		Config:  config,
+		Webhook: webhook,
+		Logger:  logger.With().Str("component", "telegram_webhook").Logger(),
+	}
+}
+
+func (p *WebhookPoller) Poll(bot *tele.Bot, updates chan tele.Update, stop chan struct{}) {
+	p.updates = updates
+	p.stop = stop
+
+	if err := bot.SetWebhook(p.Webhook); err != nil {
+		p.Logger.Panic().Err(err).Msg("Could not set Telegram webhook")
+	}
+
+	p.Logger.Info().
+		Str("addr", p.Config.ListenAddr).
+		Str("url", p.Config.PublicURL).
+		Msg("Telegram webhook is set, listening")
+
+	server := &http.Server{Addr: p.Config.ListenAddr, Handler: p}
+
+	shutdownDone := make(chan struct{})
+
+	go func() {
+		defer close(shutdownDone)
+		<-stop
+
+		ctx, cancel := context.WithTimeout(context.Background(), constants.WebhookShutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(ctx); err != nil {
+			p.Logger.Error().Err(err).Msg("Error shutting down Telegram webhook server")
+		}
+	}()
+
+	var err error
+	if p.Config.TLSCert != "" {
+		err = server.ListenAndServeTLS(p.Config.TLSCert, p.Config.TLSKey)
+	} else {
+		err = server.ListenAndServe()
+	}
+
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		p.Logger.Panic().
+			Err(err).
+			Str("addr", p.Config.ListenAddr).
+			Msg("Cannot start Telegram webhook server")
+	}
+
+	// waiting for the requests being handled, so their updates are sent before returning
+	<-shutdownDone
+}
+
+func (p *WebhookPoller) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	secretToken := r.Header.Get("X-Telegram-Bot-Api-Secret-Token")
+	if p.Config.SecretToken != "" && subtle.ConstantTimeCompare([]byte(secretToken), []byte(p.Config.SecretToken)) != 1 {
+		p.Logger.Warn().Str("remote_addr", r.RemoteAddr).Msg("Got webhook request with invalid secret token")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var update tele.Update
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		p.Logger.Warn().Err(err).Msg("Could not decode webhook update")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	// Once stopped, the updates are not read anymore, so replying with an error,
+	// for Telegram to deliver the update again, possibly to another replica.
+	select {
+	case <-p.stop:
+		p.Logger.Debug().Int("update_id", update.ID).Msg("Webhook poller is stopped, not accepting update")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	default:
+	}
+
+	select {
+	case p.updates <- update:
+	case <-p.stop:
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+}
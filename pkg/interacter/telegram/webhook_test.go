@@ -0,0 +1,186 @@
+package telegram
+
+import (
+	"main/assets"
+	loggerPkg "main/pkg/logger"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/guregu/null/v5"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+	tele "gopkg.in/telebot.v3"
+)
+
+func getWebhookPoller() (*WebhookPoller, chan tele.Update) {
+	poller := NewWebhookPoller(types.TelegramWebhookConfig{
+		Enabled:     null.BoolFrom(true),
+		ListenAddr:  "127.0.0.1:0",
+		PublicURL:   "https://example.com/webhook",
+		SecretToken: "secret",
+	}, *loggerPkg.GetNopLogger())
+
+	updates := make(chan tele.Update, 1)
+	poller.updates = updates
+
+	return poller, updates
+}
+
+func TestWebhookPollerInvalidSecretToken(t *testing.T) {
+	t.Parallel()
+
+	poller, updates := getWebhookPoller()
+
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(`{"update_id":1}`))
+	request.Header.Set("X-Telegram-Bot-Api-Secret-Token", "wrong")
+	recorder := httptest.NewRecorder()
+
+	poller.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	require.Empty(t, updates)
+}
+
+func TestWebhookPollerInvalidBody(t *testing.T) {
+	t.Parallel()
+
+	poller, updates := getWebhookPoller()
+
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader("invalid"))
+	request.Header.Set("X-Telegram-Bot-Api-Secret-Token", "secret")
+	recorder := httptest.NewRecorder()
+
+	poller.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Empty(t, updates)
+}
+
+func TestWebhookPollerOk(t *testing.T) {
+	t.Parallel()
+
+	poller, updates := getWebhookPoller()
+
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(`{"update_id":123}`))
+	request.Header.Set("X-Telegram-Bot-Api-Secret-Token", "secret")
+	recorder := httptest.NewRecorder()
+
+	poller.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Len(t, updates, 1)
+	require.Equal(t, 123, (<-updates).ID)
+}
+
+func TestWebhookPollerStopped(t *testing.T) {
+	t.Parallel()
+
+	poller, updates := getWebhookPoller()
+	poller.stop = make(chan struct{})
+	close(poller.stop)
+
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(`{"update_id":123}`))
+	request.Header.Set("X-Telegram-Bot-Api-Secret-Token", "secret")
+	recorder := httptest.NewRecorder()
+
+	poller.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	require.Empty(t, updates)
+}
+
+//nolint:paralleltest // disabled
+func TestTelegramStartWithWebhookOkay(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/setWebhook",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-webhook-ok.json")))
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/deleteWebhook",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-webhook-ok.json")))
+
+	interacter := NewInteracter(
+		types.TelegramConfig{
+			Token: "xxx:yyy",
+			Webhook: types.TelegramWebhookConfig{
+				Enabled:    null.BoolFrom(true),
+				ListenAddr: "127.0.0.1:0",
+				PublicURL:  "https://example.com/webhook",
+			},
+		},
+		"v1.2.3",
+		loggerPkg.GetNopLogger(),
+		nil,
+		nil,
+		nil,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	go interacter.Start()
+	interacter.Stop()
+
+	info := httpmock.GetCallCountInfo()
+	require.Equal(t, 1, info["POST https://api.telegram.org/botxxx:yyy/setWebhook"])
+	require.Zero(t, info["POST https://api.telegram.org/botxxx:yyy/deleteWebhook"])
+}
+
+//nolint:paralleltest // disabled
+func TestTelegramStartWithWebhookRemoveOnStop(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/getMe",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-bot-ok.json")))
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/setWebhook",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-webhook-ok.json")))
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://api.telegram.org/botxxx:yyy/deleteWebhook",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("telegram-webhook-ok.json")))
+
+	interacter := NewInteracter(
+		types.TelegramConfig{
+			Token: "xxx:yyy",
+			Webhook: types.TelegramWebhookConfig{
+				Enabled:      null.BoolFrom(true),
+				ListenAddr:   "127.0.0.1:0",
+				PublicURL:    "https://example.com/webhook",
+				RemoveOnStop: null.BoolFrom(true),
+			},
+		},
+		"v1.2.3",
+		loggerPkg.GetNopLogger(),
+		nil,
+		nil,
+		nil,
+		&timePkg.SystemTime{},
+	)
+	interacter.Init()
+
+	go interacter.Start()
+	interacter.Stop()
+
+	info := httpmock.GetCallCountInfo()
+	require.Equal(t, 1, info["POST https://api.telegram.org/botxxx:yyy/deleteWebhook"])
+}
@@ -0,0 +1,110 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"slices"
+	"strconv"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (interacter *Interacter) GetWhaleAlertCommand() Command {
+	return Command{
+		Name:    "whale_alert",
+		Execute: interacter.HandleWhaleAlert,
+	}
+}
+
+func (interacter *Interacter) GetWhaleAlertDisableCommand() Command {
+	return Command{
+		Name:    "whale_alert_disable",
+		Execute: interacter.HandleWhaleAlertDisable,
+	}
+}
+
+func (interacter *Interacter) HandleWhaleAlert(c tele.Context, chainBinds []string) (string, error) {
+	args := strings.Fields(c.Text())
+	usage := html.EscapeString(fmt.Sprintf(
+		"Usage: %s <chain> <threshold> [threshold]\n"+
+			"Thresholds are either in percents, like 5%%, or in tokens, like 100000. "+
+			"Validators changes over any of them are reported.",
+		args[0],
+	))
+
+	if len(args) < 3 || len(args) > 4 {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	if !slices.Contains(chainBinds, args[1]) {
+		return "Chain is not bound to this chat!", constants.ErrChainNotBound
+	}
+
+	subscription := &types.WhaleAlertSubscription{
+		Reporter: interacter.Name(),
+		ChatID:   strconv.FormatInt(c.Chat().ID, 10),
+		Chain:    args[1],
+	}
+
+	for _, arg := range args[2:] {
+		if err := subscription.SetThreshold(arg); err != nil {
+			return usage, constants.ErrWrongInvocation
+		}
+	}
+
+	// tokens thresholds are in the display denom, so it should be known to compare with them
+	if subscription.TokensThreshold.Valid {
+		chain, err := interacter.Database.GetChainByName(subscription.Chain)
+		if err != nil && errors.Is(err, constants.ErrChainNotFound) {
+			return interacter.ChainNotFound()
+		} else if err != nil {
+			return "", err
+		}
+
+		denoms, err := interacter.Database.GetDenomsByChain(chain)
+		if err != nil {
+			return "Error getting denoms!", err
+		}
+
+		if !slices.ContainsFunc(denoms, func(denom *types.Denom) bool { return denom.Denom == chain.BaseDenom }) {
+			return "Chain has no display denom for its base denom, add it with /denom_add or use a percent threshold!",
+				constants.ErrDenomNotFound
+		}
+	}
+
+	if err := interacter.Database.UpsertWhaleAlertSubscription(subscription); err != nil {
+		return "Error saving whale alert!", err
+	}
+
+	return html.EscapeString(fmt.Sprintf(
+		"✅ This chat will be notified when a %s validator tokens change by at least %s between checks.",
+		subscription.Chain,
+		subscription.FormatThresholds(),
+	)), nil
+}
+
+func (interacter *Interacter) HandleWhaleAlertDisable(c tele.Context, _ []string) (string, error) {
+	valid, usage, args := interacter.SingleArgParser(c.Text(), "chain")
+	if !valid {
+		return usage, constants.ErrWrongInvocation
+	}
+
+	deleted, err := interacter.Database.DeleteWhaleAlertSubscription(
+		interacter.Name(),
+		strconv.FormatInt(c.Chat().ID, 10),
+		args.Value,
+	)
+	if err != nil {
+		return "Error disabling whale alert!", err
+	}
+
+	if !deleted {
+		return "Whale alert is not enabled for this chain!", constants.ErrWhaleAlertNotEnabled
+	}
+
+	return "✅ Whale alert is disabled for this chain.", nil
+}
@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"context"
+	"errors"
 	"main/pkg/constants"
 	"main/pkg/types"
 	"main/pkg/utils"
@@ -18,6 +20,7 @@ type Manager struct {
 	config types.MetricsConfig
 
 	registry *prometheus.Registry
+	server   *http.Server
 
 	reporterEnabledGauge   *prometheus.GaugeVec
 	reporterQueriesCounter *prometheus.CounterVec
@@ -25,6 +28,24 @@ type Manager struct {
 	successQueriesCounter *prometheus.CounterVec
 	failedQueriesCounter  *prometheus.CounterVec
 
+	deduplicatedQueriesCounter *prometheus.CounterVec
+
+	websocketConnectedGauge *prometheus.GaugeVec
+
+	nakamotoCoefficientGauge *prometheus.GaugeVec
+	giniCoefficientGauge     *prometheus.GaugeVec
+	top10ShareGauge          *prometheus.GaugeVec
+	activeValidatorsGauge    *prometheus.GaugeVec
+	maxValidatorsGauge       *prometheus.GaugeVec
+
+	jobRunsCounter      *prometheus.CounterVec
+	jobFailuresCounter  *prometheus.CounterVec
+	jobRunDurationGauge *prometheus.GaugeVec
+	jobLastRunTimeGauge *prometheus.GaugeVec
+	jobLeaderGauge      *prometheus.GaugeVec
+
+	notificationsCounter *prometheus.CounterVec
+
 	appVersionGauge *prometheus.GaugeVec
 	startTimeGauge  *prometheus.GaugeVec
 }
@@ -51,6 +72,63 @@ func NewManager(logger *zerolog.Logger, config types.MetricsConfig) *Manager {
 		Help: "Counter of failed queries towards the external services.",
 	}, []string{"chain", "query", "host"})
 
+	deduplicatedQueriesCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: constants.PrometheusMetricsPrefix + "queries_deduplicated",
+		Help: "Counter of queries that were not executed as an identical query was already in flight.",
+	}, []string{"chain", "query"})
+
+	websocketConnectedGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: constants.PrometheusMetricsPrefix + "websocket_connected",
+		Help: "Whether the websocket connection to the chain RPC node is established (1 if yes, 0 if no)",
+	}, []string{"chain"})
+
+	nakamotoCoefficientGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: constants.PrometheusMetricsPrefix + "nakamoto_coefficient",
+		Help: "Minimal amount of validators having more than the threshold of voting power",
+	}, []string{"chain", "threshold"})
+	giniCoefficientGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: constants.PrometheusMetricsPrefix + "gini_coefficient",
+		Help: "Gini coefficient of the active validators voting power",
+	}, []string{"chain"})
+	top10ShareGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: constants.PrometheusMetricsPrefix + "top10_voting_power_share",
+		Help: "Share of voting power of the 10 biggest validators (0 to 1)",
+	}, []string{"chain"})
+	activeValidatorsGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: constants.PrometheusMetricsPrefix + "active_validators",
+		Help: "Amount of validators in the active set",
+	}, []string{"chain"})
+	maxValidatorsGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: constants.PrometheusMetricsPrefix + "max_validators",
+		Help: "Max amount of validators in the active set",
+	}, []string{"chain"})
+
+	jobRunsCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: constants.PrometheusMetricsPrefix + "job_runs",
+		Help: "Counter of scheduled job runs on this replica",
+	}, []string{"job"})
+	jobFailuresCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: constants.PrometheusMetricsPrefix + "job_runs_failed",
+		Help: "Counter of failed scheduled job runs on this replica",
+	}, []string{"job"})
+	jobRunDurationGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: constants.PrometheusMetricsPrefix + "job_run_duration",
+		Help: "Duration of the latest scheduled job run, in seconds",
+	}, []string{"job"})
+	jobLastRunTimeGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: constants.PrometheusMetricsPrefix + "job_last_run_time",
+		Help: "Unix timestamp of the latest scheduled job run",
+	}, []string{"job"})
+	jobLeaderGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: constants.PrometheusMetricsPrefix + "job_leader",
+		Help: "Whether this replica holds the job lock and runs it (1 if yes, 0 if no)",
+	}, []string{"job"})
+
+	notificationsCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: constants.PrometheusMetricsPrefix + "notifications",
+		Help: "Counter of notifications by type and whether they were sent, queued or muted",
+	}, []string{"type", "status"})
+
 	appVersionGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Name: constants.PrometheusMetricsPrefix + "version",
 		Help: "App version",
@@ -64,6 +142,19 @@ func NewManager(logger *zerolog.Logger, config types.MetricsConfig) *Manager {
 	registry.MustRegister(reporterQueriesCounter)
 	registry.MustRegister(successQueriesCounter)
 	registry.MustRegister(failedQueriesCounter)
+	registry.MustRegister(deduplicatedQueriesCounter)
+	registry.MustRegister(websocketConnectedGauge)
+	registry.MustRegister(nakamotoCoefficientGauge)
+	registry.MustRegister(giniCoefficientGauge)
+	registry.MustRegister(top10ShareGauge)
+	registry.MustRegister(activeValidatorsGauge)
+	registry.MustRegister(maxValidatorsGauge)
+	registry.MustRegister(jobRunsCounter)
+	registry.MustRegister(jobFailuresCounter)
+	registry.MustRegister(jobRunDurationGauge)
+	registry.MustRegister(jobLastRunTimeGauge)
+	registry.MustRegister(jobLeaderGauge)
+	registry.MustRegister(notificationsCounter)
 	registry.MustRegister(appVersionGauge)
 	registry.MustRegister(startTimeGauge)
 
@@ -71,16 +162,33 @@ func NewManager(logger *zerolog.Logger, config types.MetricsConfig) *Manager {
 		With(prometheus.Labels{}).
 		Set(float64(time.Now().Unix()))
 
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry}))
+
 	return &Manager{
-		logger:                 logger.With().Str("component", "metrics").Logger(),
-		config:                 config,
-		registry:               registry,
-		reporterEnabledGauge:   reporterEnabledGauge,
-		reporterQueriesCounter: reporterQueriesCounter,
-		successQueriesCounter:  successQueriesCounter,
-		failedQueriesCounter:   failedQueriesCounter,
-		appVersionGauge:        appVersionGauge,
-		startTimeGauge:         startTimeGauge,
+		logger:                     logger.With().Str("component", "metrics").Logger(),
+		config:                     config,
+		registry:                   registry,
+		server:                     &http.Server{Addr: config.ListenAddr, Handler: mux},
+		reporterEnabledGauge:       reporterEnabledGauge,
+		reporterQueriesCounter:     reporterQueriesCounter,
+		successQueriesCounter:      successQueriesCounter,
+		failedQueriesCounter:       failedQueriesCounter,
+		deduplicatedQueriesCounter: deduplicatedQueriesCounter,
+		websocketConnectedGauge:    websocketConnectedGauge,
+		nakamotoCoefficientGauge:   nakamotoCoefficientGauge,
+		giniCoefficientGauge:       giniCoefficientGauge,
+		top10ShareGauge:            top10ShareGauge,
+		activeValidatorsGauge:      activeValidatorsGauge,
+		maxValidatorsGauge:         maxValidatorsGauge,
+		jobRunsCounter:             jobRunsCounter,
+		jobFailuresCounter:         jobFailuresCounter,
+		jobRunDurationGauge:        jobRunDurationGauge,
+		jobLastRunTimeGauge:        jobLastRunTimeGauge,
+		jobLeaderGauge:             jobLeaderGauge,
+		notificationsCounter:       notificationsCounter,
+		appVersionGauge:            appVersionGauge,
+		startTimeGauge:             startTimeGauge,
 	}
 }
 
@@ -94,8 +202,7 @@ func (m *Manager) Start() {
 		Str("addr", m.config.ListenAddr).
 		Msg("Metrics handler listening")
 
-	http.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry}))
-	if err := http.ListenAndServe(m.config.ListenAddr, nil); err != nil {
+	if err := m.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		m.logger.Panic().
 			Err(err).
 			Str("addr", m.config.ListenAddr).
@@ -103,6 +210,21 @@ func (m *Manager) Start() {
 	}
 }
 
+// Stop shuts the metrics server down, waiting for the ongoing scrapes
+// to finish until the context is done.
+func (m *Manager) Stop(ctx context.Context) {
+	if !m.config.Enabled.Bool {
+		return
+	}
+
+	if err := m.server.Shutdown(ctx); err != nil {
+		m.logger.Error().Err(err).Msg("Error shutting down metrics handler")
+		return
+	}
+
+	m.logger.Info().Msg("Metrics handler stopped")
+}
+
 func (m *Manager) LogReporterQuery(reporter string, query string) {
 	m.reporterQueriesCounter.
 		With(prometheus.Labels{
@@ -143,3 +265,71 @@ func (m *Manager) LogQueryInfo(queryInfo types.QueryInfo) {
 			Inc()
 	}
 }
+
+func (m *Manager) LogDeduplicatedQuery(chain string, query string) {
+	m.deduplicatedQueriesCounter.
+		With(prometheus.Labels{
+			"chain": chain,
+			"query": query,
+		}).
+		Inc()
+}
+
+func (m *Manager) LogWebsocketConnected(chain string, connected bool) {
+	m.websocketConnectedGauge.
+		With(prometheus.Labels{"chain": chain}).
+		Set(utils.BoolToFloat64(connected))
+}
+
+func (m *Manager) LogDecentralizationStats(chain string, stats *types.DecentralizationStats) {
+	m.nakamotoCoefficientGauge.
+		With(prometheus.Labels{"chain": chain, "threshold": "33"}).
+		Set(float64(stats.NakamotoCoefficient33))
+	m.nakamotoCoefficientGauge.
+		With(prometheus.Labels{"chain": chain, "threshold": "66"}).
+		Set(float64(stats.NakamotoCoefficient66))
+	m.giniCoefficientGauge.
+		With(prometheus.Labels{"chain": chain}).
+		Set(stats.GiniCoefficient)
+	m.top10ShareGauge.
+		With(prometheus.Labels{"chain": chain}).
+		Set(stats.Top10Share)
+	m.activeValidatorsGauge.
+		With(prometheus.Labels{"chain": chain}).
+		Set(float64(stats.ActiveValidators))
+	m.maxValidatorsGauge.
+		With(prometheus.Labels{"chain": chain}).
+		Set(float64(stats.MaxValidators))
+}
+
+func (m *Manager) LogJobRun(job string, startTime time.Time, duration time.Duration, err error) {
+	m.jobRunsCounter.
+		With(prometheus.Labels{"job": job}).
+		Inc()
+
+	if err != nil {
+		m.jobFailuresCounter.
+			With(prometheus.Labels{"job": job}).
+			Inc()
+	}
+
+	m.jobRunDurationGauge.
+		With(prometheus.Labels{"job": job}).
+		Set(duration.Seconds())
+
+	m.jobLastRunTimeGauge.
+		With(prometheus.Labels{"job": job}).
+		Set(float64(startTime.Unix()))
+}
+
+func (m *Manager) LogJobLeader(job string, isLeader bool) {
+	m.jobLeaderGauge.
+		With(prometheus.Labels{"job": job}).
+		Set(utils.BoolToFloat64(isLeader))
+}
+
+func (m *Manager) LogNotification(notificationType string, status string) {
+	m.notificationsCounter.
+		With(prometheus.Labels{"type": notificationType, "status": status}).
+		Inc()
+}
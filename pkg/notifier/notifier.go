@@ -0,0 +1,265 @@
+package notifier
+
+import (
+	"main/pkg/constants"
+	databasePkg "main/pkg/database"
+	interacterPkg "main/pkg/interacter"
+	"main/pkg/metrics"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"main/pkg/utils"
+
+	"github.com/rs/zerolog"
+)
+
+// Notifier delivers events to the users and chats interested in them,
+// through the interacter they came from, taking their notification settings into account:
+// muted events are dropped, and events during quiet hours or for chats in digest mode
+// are queued and delivered later in one message.
+type Notifier struct {
+	Logger         zerolog.Logger
+	Config         types.NotifierConfig
+	Database       *databasePkg.Database
+	MetricsManager *metrics.Manager
+	Interacters    []interacterPkg.Interacter
+	Time           timePkg.Time
+}
+
+func NewNotifier(
+	config types.NotifierConfig,
+	logger *zerolog.Logger,
+	database *databasePkg.Database,
+	metricsManager *metrics.Manager,
+	interacters []interacterPkg.Interacter,
+	timer timePkg.Time,
+) *Notifier {
+	return &Notifier{
+		Logger:         logger.With().Str("component", "notifier").Logger(),
+		Config:         config,
+		Database:       database,
+		MetricsManager: metricsManager,
+		Interacters:    interacters,
+		Time:           timer,
+	}
+}
+
+// Publish notifies the users having the event wallet or validator linked,
+// or the chats bound to the event chain.
+func (n *Notifier) Publish(event *types.NotificationEvent) {
+	recipients, err := n.GetRecipients(event)
+	if err != nil {
+		n.Logger.Error().
+			Err(err).
+			Str("type", string(event.Type)).
+			Str("chain", event.Chain).
+			Msg("Error getting notification recipients")
+		return
+	}
+
+	n.PublishTo(recipients, event)
+}
+
+// PublishTo notifies the given recipients, for events whose recipients
+// are already known, like a wallet link with its own notification threshold.
+func (n *Notifier) PublishTo(recipients []*types.NotificationRecipient, event *types.NotificationEvent) {
+	for _, recipient := range recipients {
+		if err := n.Deliver(recipient, event); err != nil {
+			n.Logger.Error().
+				Err(err).
+				Str("type", string(event.Type)).
+				Str("chain", event.Chain).
+				Str("reporter", recipient.Reporter).
+				Str("chat", recipient.ChatID).
+				Msg("Error delivering notification")
+		}
+	}
+}
+
+func (n *Notifier) GetRecipients(event *types.NotificationEvent) ([]*types.NotificationRecipient, error) {
+	var (
+		recipients []*types.NotificationRecipient
+		err        error
+	)
+
+	switch {
+	case event.WalletAddress != "":
+		recipients, err = n.Database.GetWalletLinksRecipients(event.Chain, event.WalletAddress)
+	case event.ValidatorAddress != "":
+		recipients, err = n.Database.GetValidatorLinksRecipients(event.Chain, event.ValidatorAddress)
+	default:
+		recipients, err = n.Database.GetChainBindsRecipients(event.Chain)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A user can have the same wallet linked on behalf of different chats.
+	unique := make([]*types.NotificationRecipient, 0, len(recipients))
+	seen := map[types.NotificationRecipient]bool{}
+
+	for _, recipient := range recipients {
+		if !seen[*recipient] {
+			seen[*recipient] = true
+			unique = append(unique, recipient)
+		}
+	}
+
+	return unique, nil
+}
+
+func (n *Notifier) Deliver(recipient *types.NotificationRecipient, event *types.NotificationEvent) error {
+	interacter, found := n.GetInteracter(recipient.Reporter)
+	if !found {
+		n.Logger.Warn().
+			Str("reporter", recipient.Reporter).
+			Msg("Reporter is not found or disabled, cannot send notification")
+		return nil
+	}
+
+	settings, err := n.Database.GetNotificationSettings(recipient.Reporter, recipient.ChatID)
+	if err != nil {
+		return err
+	}
+
+	if settings.IsMuted(event) {
+		n.MetricsManager.LogNotification(string(event.Type), constants.NotificationStatusMuted)
+		return nil
+	}
+
+	text, err := interacter.RenderNotification(event)
+	if err != nil {
+		return err
+	}
+
+	if settings.IsDigest() || settings.IsQuietTime(n.Time.Now()) {
+		n.MetricsManager.LogNotification(string(event.Type), constants.NotificationStatusQueued)
+
+		return n.Database.InsertQueuedNotification(&types.QueuedNotification{
+			Reporter: recipient.Reporter,
+			ChatID:   recipient.ChatID,
+			Type:     event.Type,
+			Chain:    event.Chain,
+			Text:     text,
+		})
+	}
+
+	if err := interacter.SendNotification(recipient.ChatID, text); err != nil {
+		return err
+	}
+
+	n.MetricsManager.LogNotification(string(event.Type), constants.NotificationStatusSent)
+	return nil
+}
+
+// Send delivers the event right away, ignoring the chat notification settings,
+// for the messages the chat has explicitly asked for, like digests and price alerts.
+func (n *Notifier) Send(recipient *types.NotificationRecipient, event *types.NotificationEvent) error {
+	interacter, found := n.GetInteracter(recipient.Reporter)
+	if !found {
+		n.Logger.Warn().
+			Str("reporter", recipient.Reporter).
+			Msg("Reporter is not found or disabled, cannot send notification")
+		return nil
+	}
+
+	text, err := interacter.RenderNotification(event)
+	if err != nil {
+		return err
+	}
+
+	if err := interacter.SendNotification(recipient.ChatID, text); err != nil {
+		return err
+	}
+
+	n.MetricsManager.LogNotification(string(event.Type), constants.NotificationStatusSent)
+	return nil
+}
+
+// Flush delivers the queued notifications of the chats which quiet hours are over,
+// or which digest is due, as one message per chat.
+func (n *Notifier) Flush() error {
+	queued, err := n.Database.GetQueuedNotifications()
+	if err != nil {
+		return err
+	}
+
+	// Grouping by chat keeping the queue order, so chats are flushed in a stable order.
+	chats := make([]types.NotificationRecipient, 0)
+	byChat := map[types.NotificationRecipient][]*types.QueuedNotification{}
+
+	for _, notification := range queued {
+		chat := types.NotificationRecipient{Reporter: notification.Reporter, ChatID: notification.ChatID}
+		if _, ok := byChat[chat]; !ok {
+			chats = append(chats, chat)
+		}
+
+		byChat[chat] = append(byChat[chat], notification)
+	}
+
+	for _, chat := range chats {
+		notifications := byChat[chat]
+
+		if err := n.FlushChat(notifications); err != nil {
+			n.Logger.Error().
+				Err(err).
+				Str("reporter", notifications[0].Reporter).
+				Str("chat", notifications[0].ChatID).
+				Msg("Error delivering queued notifications")
+		}
+	}
+
+	return nil
+}
+
+// FlushChat delivers the queued notifications of a single chat, sorted by their queue order.
+func (n *Notifier) FlushChat(notifications []*types.QueuedNotification) error {
+	first, last := notifications[0], notifications[len(notifications)-1]
+
+	interacter, found := n.GetInteracter(first.Reporter)
+	if !found {
+		return nil
+	}
+
+	settings, err := n.Database.GetNotificationSettings(first.Reporter, first.ChatID)
+	if err != nil {
+		return err
+	}
+
+	now := n.Time.Now()
+
+	if settings.IsQuietTime(now) {
+		return nil
+	}
+
+	if settings.IsDigest() && now.Sub(first.CreatedAt) < n.Config.DigestInterval {
+		return nil
+	}
+
+	text, err := interacter.RenderQueuedNotifications(notifications)
+	if err != nil {
+		return err
+	}
+
+	if err := interacter.SendNotification(first.ChatID, text); err != nil {
+		return err
+	}
+
+	for _, notification := range notifications {
+		n.MetricsManager.LogNotification(string(notification.Type), constants.NotificationStatusSent)
+	}
+
+	return n.Database.DeleteQueuedNotifications(first.Reporter, first.ChatID, last.ID)
+}
+
+func (n *Notifier) GetInteracter(reporter string) (interacterPkg.Interacter, bool) {
+	interacter, found := utils.Find(n.Interacters, func(i interacterPkg.Interacter) bool {
+		return i.Name() == reporter
+	})
+
+	if !found || !interacter.Enabled() {
+		return nil, false
+	}
+
+	return interacter, true
+}
@@ -0,0 +1,347 @@
+package notifier
+
+import (
+	"errors"
+	databasePkg "main/pkg/database"
+	interacterPkg "main/pkg/interacter"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+type StubInteracter struct {
+	Sent map[string][]string
+}
+
+func (i *StubInteracter) Name() string  { return "telegram" }
+func (i *StubInteracter) Enabled() bool { return true }
+func (i *StubInteracter) Init()         {}
+func (i *StubInteracter) Start()        {}
+func (i *StubInteracter) Stop()         {}
+
+func (i *StubInteracter) RenderNotification(event *types.NotificationEvent) (string, error) {
+	if event.Template == "" {
+		return "", errors.New("no template")
+	}
+
+	return event.Template, nil
+}
+
+func (i *StubInteracter) RenderQueuedNotifications(notifications []*types.QueuedNotification) (string, error) {
+	texts := make([]string, len(notifications))
+	for index, notification := range notifications {
+		texts[index] = notification.Text
+	}
+
+	return strings.Join(texts, ","), nil
+}
+
+func (i *StubInteracter) SendNotification(chatID string, text string) error {
+	if i.Sent == nil {
+		i.Sent = map[string][]string{}
+	}
+
+	i.Sent[chatID] = append(i.Sent[chatID], text)
+	return nil
+}
+
+var settingsColumns = []string{
+	"muted_types",
+	"muted_chains",
+	"mode",
+	"timezone",
+	"quiet_hours_start",
+	"quiet_hours_end",
+}
+
+var queueColumns = []string{"id", "reporter", "chat_id", "type", "chain", "text", "created_at"}
+
+func getNotifier(t *testing.T, interacter *StubInteracter, now time.Time) (*Notifier, sqlmock.Sqlmock) {
+	t.Helper()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	database.SetClient(db)
+
+	notifier := NewNotifier(
+		types.NotifierConfig{FlushInterval: time.Minute, DigestInterval: time.Hour},
+		logger,
+		database,
+		metricsManager,
+		[]interacterPkg.Interacter{interacter},
+		&timePkg.StubTime{NowTime: now},
+	)
+
+	return notifier, mock
+}
+
+func TestNotifierPublishErrorGettingRecipients(t *testing.T) {
+	t.Parallel()
+
+	interacter := &StubInteracter{}
+	notifier, mock := getNotifier(t, interacter, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	mock.ExpectQuery("SELECT reporter, chat_id FROM chain_binds").
+		WillReturnError(errors.New("custom error"))
+
+	notifier.Publish(&types.NotificationEvent{Type: types.NotificationTypeUpgrade, Chain: "chain1", Template: "upgrade"})
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Sent)
+}
+
+func TestNotifierPublishSends(t *testing.T) {
+	t.Parallel()
+
+	interacter := &StubInteracter{}
+	notifier, mock := getNotifier(t, interacter, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	mock.ExpectQuery("SELECT reporter, user_id FROM validator_links").
+		WithArgs("chain1", "cosmosvaloper1xxx").
+		WillReturnRows(sqlmock.NewRows([]string{"reporter", "user_id"}).
+			AddRow("telegram", "1").
+			AddRow("telegram", "1").
+			AddRow("discord", "2"))
+
+	mock.ExpectQuery("SELECT muted_types, muted_chains, mode, timezone, quiet_hours_start, quiet_hours_end FROM notification_settings").
+		WithArgs("telegram", "1").
+		WillReturnRows(sqlmock.NewRows(settingsColumns))
+
+	notifier.Publish(&types.NotificationEvent{
+		Type:             types.NotificationTypeActiveSet,
+		Chain:            "chain1",
+		ValidatorAddress: "cosmosvaloper1xxx",
+		Template:         "active_set_warning",
+	})
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, map[string][]string{"1": {"active_set_warning"}}, interacter.Sent)
+}
+
+func TestNotifierPublishMuted(t *testing.T) {
+	t.Parallel()
+
+	interacter := &StubInteracter{}
+	notifier, mock := getNotifier(t, interacter, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	mock.ExpectQuery("SELECT reporter, user_id FROM wallet_links").
+		WithArgs("chain1", "cosmos1xxx").
+		WillReturnRows(sqlmock.NewRows([]string{"reporter", "user_id"}).AddRow("telegram", "1"))
+
+	mock.ExpectQuery("SELECT muted_types, muted_chains, mode, timezone, quiet_hours_start, quiet_hours_end FROM notification_settings").
+		WillReturnRows(sqlmock.NewRows(settingsColumns).AddRow("{}", "{chain1}", "instant", "UTC", nil, nil))
+
+	notifier.Publish(&types.NotificationEvent{
+		Type:          types.NotificationTypeTransfer,
+		Chain:         "chain1",
+		WalletAddress: "cosmos1xxx",
+		Template:      "transfer",
+	})
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Sent)
+}
+
+func TestNotifierPublishRenderError(t *testing.T) {
+	t.Parallel()
+
+	interacter := &StubInteracter{}
+	notifier, mock := getNotifier(t, interacter, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	mock.ExpectQuery("SELECT muted_types, muted_chains, mode, timezone, quiet_hours_start, quiet_hours_end FROM notification_settings").
+		WillReturnRows(sqlmock.NewRows(settingsColumns))
+
+	notifier.PublishTo(
+		[]*types.NotificationRecipient{{Reporter: "telegram", ChatID: "1"}},
+		&types.NotificationEvent{Type: types.NotificationTypeTransfer, Chain: "chain1"},
+	)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Sent)
+}
+
+func TestNotifierPublishQueuedDuringQuietHours(t *testing.T) {
+	t.Parallel()
+
+	interacter := &StubInteracter{}
+	notifier, mock := getNotifier(t, interacter, time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC))
+
+	mock.ExpectQuery("SELECT muted_types, muted_chains, mode, timezone, quiet_hours_start, quiet_hours_end FROM notification_settings").
+		WillReturnRows(sqlmock.NewRows(settingsColumns).AddRow("{}", "{}", "instant", "UTC", 23*60, 7*60))
+
+	mock.ExpectExec("INSERT INTO notifications_queue").
+		WithArgs("telegram", "1", types.NotificationTypeUpgrade, "chain1", "upgrade").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	notifier.PublishTo(
+		[]*types.NotificationRecipient{{Reporter: "telegram", ChatID: "1"}},
+		&types.NotificationEvent{Type: types.NotificationTypeUpgrade, Chain: "chain1", Template: "upgrade"},
+	)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Sent)
+}
+
+func TestNotifierPublishQueuedInDigestMode(t *testing.T) {
+	t.Parallel()
+
+	interacter := &StubInteracter{}
+	notifier, mock := getNotifier(t, interacter, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	mock.ExpectQuery("SELECT muted_types, muted_chains, mode, timezone, quiet_hours_start, quiet_hours_end FROM notification_settings").
+		WillReturnRows(sqlmock.NewRows(settingsColumns).AddRow("{}", "{}", "digest", "UTC", nil, nil))
+
+	mock.ExpectExec("INSERT INTO notifications_queue").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	notifier.PublishTo(
+		[]*types.NotificationRecipient{{Reporter: "telegram", ChatID: "1"}},
+		&types.NotificationEvent{Type: types.NotificationTypeUpgrade, Chain: "chain1", Template: "upgrade"},
+	)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Sent)
+}
+
+func TestNotifierPublishUnknownReporter(t *testing.T) {
+	t.Parallel()
+
+	interacter := &StubInteracter{}
+	notifier, mock := getNotifier(t, interacter, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	notifier.PublishTo(
+		[]*types.NotificationRecipient{{Reporter: "discord", ChatID: "1"}},
+		&types.NotificationEvent{Type: types.NotificationTypeUpgrade, Chain: "chain1", Template: "upgrade"},
+	)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Sent)
+}
+
+func TestNotifierFlushErrorGettingQueue(t *testing.T) {
+	t.Parallel()
+
+	interacter := &StubInteracter{}
+	notifier, mock := getNotifier(t, interacter, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	mock.ExpectQuery("SELECT id, reporter, chat_id, type, chain, text, created_at FROM notifications_queue").
+		WillReturnError(errors.New("custom error"))
+
+	require.Error(t, notifier.Flush())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotifierFlushOk(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	interacter := &StubInteracter{}
+	notifier, mock := getNotifier(t, interacter, now)
+
+	mock.ExpectQuery("SELECT id, reporter, chat_id, type, chain, text, created_at FROM notifications_queue").
+		WillReturnRows(sqlmock.NewRows(queueColumns).
+			AddRow(1, "telegram", "1", "upgrade", "chain1", "first", now.Add(-10*time.Minute)).
+			AddRow(2, "telegram", "2", "upgrade", "chain1", "second", now.Add(-10*time.Minute)).
+			AddRow(3, "telegram", "1", "transfer", "chain1", "third", now.Add(-5*time.Minute)).
+			AddRow(4, "telegram", "3", "transfer", "chain1", "fourth", now.Add(-5*time.Minute)).
+			AddRow(5, "telegram", "4", "transfer", "chain1", "fifth", now.Add(-5*time.Minute)))
+
+	// Chat 1 quiet hours are over, so its notifications are delivered.
+	mock.ExpectQuery("SELECT muted_types, muted_chains, mode, timezone, quiet_hours_start, quiet_hours_end FROM notification_settings").
+		WithArgs("telegram", "1").
+		WillReturnRows(sqlmock.NewRows(settingsColumns).AddRow("{}", "{}", "instant", "UTC", 23*60, 7*60))
+	mock.ExpectExec("DELETE FROM notifications_queue").
+		WithArgs("telegram", "1", int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	// Chat 2 is in quiet hours still.
+	mock.ExpectQuery("SELECT muted_types, muted_chains, mode, timezone, quiet_hours_start, quiet_hours_end FROM notification_settings").
+		WithArgs("telegram", "2").
+		WillReturnRows(sqlmock.NewRows(settingsColumns).AddRow("{}", "{}", "instant", "UTC", 11*60, 13*60))
+
+	// Chat 3 digest is not due yet.
+	mock.ExpectQuery("SELECT muted_types, muted_chains, mode, timezone, quiet_hours_start, quiet_hours_end FROM notification_settings").
+		WithArgs("telegram", "3").
+		WillReturnRows(sqlmock.NewRows(settingsColumns).AddRow("{}", "{}", "digest", "UTC", nil, nil))
+
+	// Chat 4 fails to delete the delivered notifications, which does not stop the flush.
+	mock.ExpectQuery("SELECT muted_types, muted_chains, mode, timezone, quiet_hours_start, quiet_hours_end FROM notification_settings").
+		WithArgs("telegram", "4").
+		WillReturnRows(sqlmock.NewRows(settingsColumns))
+	mock.ExpectExec("DELETE FROM notifications_queue").
+		WithArgs("telegram", "4", int64(5)).
+		WillReturnError(errors.New("custom error"))
+
+	require.NoError(t, notifier.Flush())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, map[string][]string{
+		"1": {"first,third"},
+		"4": {"fifth"},
+	}, interacter.Sent)
+}
+
+func TestNotifierFlushDigestDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	interacter := &StubInteracter{}
+	notifier, mock := getNotifier(t, interacter, now)
+
+	mock.ExpectQuery("SELECT id, reporter, chat_id, type, chain, text, created_at FROM notifications_queue").
+		WillReturnRows(sqlmock.NewRows(queueColumns).
+			AddRow(1, "telegram", "1", "upgrade", "chain1", "first", now.Add(-2*time.Hour)).
+			AddRow(2, "telegram", "1", "upgrade", "chain2", "second", now.Add(-time.Minute)))
+
+	mock.ExpectQuery("SELECT muted_types, muted_chains, mode, timezone, quiet_hours_start, quiet_hours_end FROM notification_settings").
+		WillReturnRows(sqlmock.NewRows(settingsColumns).AddRow("{}", "{}", "digest", "UTC", nil, nil))
+	mock.ExpectExec("DELETE FROM notifications_queue").
+		WithArgs("telegram", "1", int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	require.NoError(t, notifier.Flush())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, map[string][]string{"1": {"first,second"}}, interacter.Sent)
+}
+
+func TestNotifierSendIgnoresSettings(t *testing.T) {
+	t.Parallel()
+
+	interacter := &StubInteracter{}
+	notifier, mock := getNotifier(t, interacter, time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC))
+
+	err := notifier.Send(
+		&types.NotificationRecipient{Reporter: "telegram", ChatID: "1"},
+		&types.NotificationEvent{Type: types.NotificationTypeDigest, Template: "digest"},
+	)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, map[string][]string{"1": {"digest"}}, interacter.Sent)
+}
+
+func TestNotifierSendRenderError(t *testing.T) {
+	t.Parallel()
+
+	interacter := &StubInteracter{}
+	notifier, _ := getNotifier(t, interacter, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	err := notifier.Send(
+		&types.NotificationRecipient{Reporter: "telegram", ChatID: "1"},
+		&types.NotificationEvent{Type: types.NotificationTypeDigest},
+	)
+	require.Error(t, err)
+	require.Empty(t, interacter.Sent)
+}
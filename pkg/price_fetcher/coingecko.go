@@ -30,18 +30,32 @@ func NewCoingeckoPriceFetcher(
 }
 
 func (c *CoingeckoPriceFetcher) GetPrices(denomInfos []*types.Denom) (Prices, error) {
+	prices, _, err := c.FetchPrices(denomInfos, false)
+	return prices, err
+}
+
+func (c *CoingeckoPriceFetcher) GetPricesWithChanges(denomInfos []*types.Denom) (Prices, Prices, error) {
+	return c.FetchPrices(denomInfos, true)
+}
+
+func (c *CoingeckoPriceFetcher) FetchPrices(denomInfos []*types.Denom, withChanges bool) (Prices, Prices, error) {
 	currenciesToFetch := utils.Map(denomInfos, func(denomInfo *types.Denom) string {
 		return denomInfo.CoingeckoCurrency.String
 	})
 
+	query := fmt.Sprintf(
+		"/api/v3/simple/price?ids=%s&vs_currencies=%s",
+		strings.Join(currenciesToFetch, ","),
+		constants.CoingeckoBaseCurrency,
+	)
+	if withChanges {
+		query += "&include_24hr_change=true"
+	}
+
 	var coingeckoResponse map[string]map[string]float64
 	queryInfo, err := c.Client.Get(
 		"https://api.coingecko.com",
-		fmt.Sprintf(
-			"/api/v3/simple/price?ids=%s&vs_currencies=%s",
-			strings.Join(currenciesToFetch, ","),
-			constants.CoingeckoBaseCurrency,
-		),
+		query,
 		"fetch_prices",
 		&coingeckoResponse,
 	)
@@ -53,14 +67,15 @@ func (c *CoingeckoPriceFetcher) GetPrices(denomInfos []*types.Denom) (Prices, er
 			Err(err).
 			Strs("currencies", currenciesToFetch).
 			Msg("Could not get rates, probably rate-limiting")
-		return map[string]map[string]float64{}, err
+		return Prices{}, Prices{}, err
 	}
 
-	result := make(map[string]map[string]float64)
+	prices := Prices{}
+	changes := Prices{}
 
 	for _, denomInfo := range denomInfos {
-		if _, ok := result[denomInfo.Chain]; !ok {
-			result[denomInfo.Chain] = make(map[string]float64)
+		if _, ok := prices[denomInfo.Chain]; !ok {
+			prices[denomInfo.Chain] = make(map[string]float64)
 		}
 
 		coinPrice, ok := coingeckoResponse[denomInfo.CoingeckoCurrency.String]
@@ -69,11 +84,15 @@ func (c *CoingeckoPriceFetcher) GetPrices(denomInfos []*types.Denom) (Prices, er
 		}
 
 		if usdCoinPrice, ok := coinPrice[constants.CoingeckoBaseCurrency]; ok {
-			result[denomInfo.Chain][denomInfo.Denom] = usdCoinPrice
+			prices.Set(denomInfo.Chain, denomInfo.Denom, usdCoinPrice)
+		}
+
+		if usdCoinChange, ok := coinPrice[constants.CoingeckoBaseCurrency+"_24h_change"]; ok {
+			changes.Set(denomInfo.Chain, denomInfo.Denom, usdCoinChange)
 		}
 	}
 
-	return result, nil
+	return prices, changes, nil
 }
 
 func (c *CoingeckoPriceFetcher) Name() string {
@@ -26,3 +26,10 @@ type PriceFetcher interface {
 	GetPrices(denomInfos []*types.Denom) (Prices, error)
 	Name() string
 }
+
+// PriceChangesFetcher is a PriceFetcher that can also return the 24h price change,
+// in percents, keyed the same way as prices.
+type PriceChangesFetcher interface {
+	PriceFetcher
+	GetPricesWithChanges(denomInfos []*types.Denom) (Prices, Prices, error)
+}
@@ -0,0 +1,243 @@
+package scheduler
+
+import (
+	"fmt"
+	"hash/fnv"
+	databasePkg "main/pkg/database"
+	"main/pkg/metrics"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type JobFunc func() error
+
+// Job is a function run periodically. When several replicas are running,
+// only the one holding the job's advisory lock runs it, so a job is never
+// run twice, and another replica takes it over if this one goes down.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Func     JobFunc
+
+	lock   *databasePkg.AdvisoryLock
+	status types.JobStatus
+	mutex  sync.Mutex
+}
+
+// LockKey is the advisory lock key, derived from the job name.
+func (j *Job) LockKey() int64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte("astronomer:job:" + j.Name))
+	return int64(hash.Sum64()) //nolint:gosec // overflow is fine for a lock key
+}
+
+func (j *Job) GetStatus() types.JobStatus {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	return j.status
+}
+
+type Scheduler struct {
+	Logger         zerolog.Logger
+	Database       *databasePkg.Database
+	MetricsManager *metrics.Manager
+	Time           timePkg.Time
+	Jobs           []*Job
+
+	wg          sync.WaitGroup
+	StopChannel chan bool
+}
+
+func NewScheduler(
+	logger *zerolog.Logger,
+	database *databasePkg.Database,
+	metricsManager *metrics.Manager,
+	timer timePkg.Time,
+) *Scheduler {
+	return &Scheduler{
+		Logger:         logger.With().Str("component", "scheduler").Logger(),
+		Database:       database,
+		MetricsManager: metricsManager,
+		Time:           timer,
+		Jobs:           []*Job{},
+		StopChannel:    make(chan bool),
+	}
+}
+
+// Register adds a job, it should be called before Start.
+func (s *Scheduler) Register(name string, interval time.Duration, jobFunc JobFunc) {
+	s.Jobs = append(s.Jobs, &Job{
+		Name:     name,
+		Interval: interval,
+		Func:     jobFunc,
+		status:   types.JobStatus{Name: name, Interval: interval},
+	})
+}
+
+func (s *Scheduler) Enabled() bool {
+	return len(s.Jobs) > 0
+}
+
+func (s *Scheduler) Start() {
+	s.wg.Add(len(s.Jobs))
+
+	for _, job := range s.Jobs {
+		s.Logger.Info().
+			Str("job", job.Name).
+			Dur("interval", job.Interval).
+			Msg("Scheduling job")
+
+		go s.Loop(job)
+	}
+
+	s.wg.Wait()
+}
+
+// Stop waits for the running jobs to finish and releases their locks,
+// so other replicas can take them over.
+func (s *Scheduler) Stop() {
+	s.Logger.Info().Msg("Shutting down...")
+	close(s.StopChannel)
+	s.wg.Wait()
+}
+
+func (s *Scheduler) Loop(job *Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.Tick(job)
+
+		select {
+		case <-ticker.C:
+		case <-s.StopChannel:
+			s.ReleaseLeadership(job)
+			return
+		}
+	}
+}
+
+// Tick runs the job if this replica holds its lock or manages to acquire it.
+func (s *Scheduler) Tick(job *Job) {
+	if !s.EnsureLeadership(job) {
+		return
+	}
+
+	s.Run(job)
+}
+
+func (s *Scheduler) EnsureLeadership(job *Job) bool {
+	if job.lock != nil {
+		err := s.Database.CheckAdvisoryLock(job.lock)
+		if err == nil {
+			return true
+		}
+
+		s.Logger.Warn().Err(err).Str("job", job.Name).Msg("Lost job lock connection")
+		_ = s.Database.ReleaseAdvisoryLock(job.lock)
+		job.lock = nil
+		s.SetLeader(job, false)
+	}
+
+	lock, err := s.Database.TryAdvisoryLock(job.LockKey())
+	if err != nil {
+		s.Logger.Error().Err(err).Str("job", job.Name).Msg("Error acquiring job lock")
+		return false
+	}
+
+	if lock == nil {
+		s.Logger.Trace().Str("job", job.Name).Msg("Job lock is held by another replica, not running")
+		s.SetLeader(job, false)
+		return false
+	}
+
+	s.Logger.Info().Str("job", job.Name).Msg("Acquired job lock, running the job on this replica")
+	job.lock = lock
+	s.SetLeader(job, true)
+	return true
+}
+
+func (s *Scheduler) ReleaseLeadership(job *Job) {
+	if job.lock == nil {
+		return
+	}
+
+	if err := s.Database.ReleaseAdvisoryLock(job.lock); err == nil {
+		s.Logger.Info().Str("job", job.Name).Msg("Released job lock")
+	}
+
+	job.lock = nil
+	s.SetLeader(job, false)
+}
+
+func (s *Scheduler) SetLeader(job *Job, isLeader bool) {
+	job.mutex.Lock()
+	job.status.IsLeader = isLeader
+	job.mutex.Unlock()
+
+	s.MetricsManager.LogJobLeader(job.Name, isLeader)
+}
+
+func (s *Scheduler) Run(job *Job) {
+	job.mutex.Lock()
+	job.status.IsRunning = true
+	job.mutex.Unlock()
+
+	startTime := s.Time.Now()
+	err := s.RunSafe(job)
+	duration := s.Time.Since(startTime)
+
+	job.mutex.Lock()
+	job.status.IsRunning = false
+	job.status.LastRunTime = startTime
+	job.status.LastRunDuration = duration
+	job.status.Runs++
+	job.status.LastError = ""
+	if err != nil {
+		job.status.Failures++
+		job.status.LastError = err.Error()
+	}
+	job.mutex.Unlock()
+
+	s.MetricsManager.LogJobRun(job.Name, startTime, duration, err)
+
+	if err != nil {
+		s.Logger.Error().
+			Err(err).
+			Str("job", job.Name).
+			Dur("duration", duration).
+			Msg("Job failed")
+		return
+	}
+
+	s.Logger.Debug().
+		Str("job", job.Name).
+		Dur("duration", duration).
+		Msg("Job finished")
+}
+
+// RunSafe runs the job, turning a panic into an error,
+// so a failing job does not take the whole app down.
+func (s *Scheduler) RunSafe(job *Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return job.Func()
+}
+
+func (s *Scheduler) GetJobsStatuses() []types.JobStatus {
+	return utils.Map(s.Jobs, func(job *Job) types.JobStatus {
+		return job.GetStatus()
+	})
+}
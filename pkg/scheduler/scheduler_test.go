@@ -0,0 +1,190 @@
+package scheduler
+
+import (
+	"errors"
+	databasePkg "main/pkg/database"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func getScheduler(t *testing.T) (*Scheduler, sqlmock.Sqlmock) {
+	t.Helper()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	database.SetClient(db)
+
+	timer := &timePkg.StubTime{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	return NewScheduler(logger, database, metricsManager, timer), mock
+}
+
+func TestJobLockKey(t *testing.T) {
+	t.Parallel()
+
+	first := &Job{Name: "first"}
+	second := &Job{Name: "second"}
+
+	require.Equal(t, first.LockKey(), (&Job{Name: "first"}).LockKey())
+	require.NotEqual(t, first.LockKey(), second.LockKey())
+}
+
+func TestSchedulerTickErrorAcquiringLock(t *testing.T) {
+	t.Parallel()
+
+	scheduler, mock := getScheduler(t)
+
+	runs := 0
+	scheduler.Register("job", time.Minute, func() error {
+		runs++
+		return nil
+	})
+	job := scheduler.Jobs[0]
+
+	mock.ExpectQuery("SELECT pg_try_advisory_lock").
+		WithArgs(job.LockKey()).
+		WillReturnError(errors.New("custom error"))
+
+	scheduler.Tick(job)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Zero(t, runs)
+	require.False(t, job.GetStatus().IsLeader)
+}
+
+func TestSchedulerTickLockHeldByAnotherReplica(t *testing.T) {
+	t.Parallel()
+
+	scheduler, mock := getScheduler(t)
+
+	runs := 0
+	scheduler.Register("job", time.Minute, func() error {
+		runs++
+		return nil
+	})
+	job := scheduler.Jobs[0]
+
+	mock.ExpectQuery("SELECT pg_try_advisory_lock").
+		WithArgs(job.LockKey()).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(false))
+
+	scheduler.Tick(job)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Zero(t, runs)
+	require.False(t, job.GetStatus().IsLeader)
+	require.False(t, job.GetStatus().HasRun())
+}
+
+func TestSchedulerTickLeaderRunsJob(t *testing.T) {
+	t.Parallel()
+
+	scheduler, mock := getScheduler(t)
+
+	runs := 0
+	scheduler.Register("job", time.Minute, func() error {
+		runs++
+		return nil
+	})
+	job := scheduler.Jobs[0]
+
+	mock.ExpectQuery("SELECT pg_try_advisory_lock").
+		WithArgs(job.LockKey()).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+
+	// The lock is kept between ticks, so it is acquired only once.
+	scheduler.Tick(job)
+	scheduler.Tick(job)
+
+	mock.ExpectExec("SELECT pg_advisory_unlock").
+		WithArgs(job.LockKey()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	scheduler.ReleaseLeadership(job)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, 2, runs)
+
+	status := job.GetStatus()
+	require.False(t, status.IsLeader)
+	require.True(t, status.HasRun())
+	require.Equal(t, int64(2), status.Runs)
+	require.Zero(t, status.Failures)
+	require.Empty(t, status.LastError)
+}
+
+func TestSchedulerRunFailed(t *testing.T) {
+	t.Parallel()
+
+	scheduler, _ := getScheduler(t)
+	scheduler.Register("job", time.Minute, func() error {
+		return errors.New("custom error")
+	})
+	job := scheduler.Jobs[0]
+
+	scheduler.Run(job)
+
+	status := job.GetStatus()
+	require.Equal(t, int64(1), status.Runs)
+	require.Equal(t, int64(1), status.Failures)
+	require.Equal(t, "custom error", status.LastError)
+	require.False(t, status.IsRunning)
+}
+
+func TestSchedulerRunPanicked(t *testing.T) {
+	t.Parallel()
+
+	scheduler, _ := getScheduler(t)
+	scheduler.Register("job", time.Minute, func() error {
+		panic("custom panic")
+	})
+	job := scheduler.Jobs[0]
+
+	scheduler.Run(job)
+
+	status := job.GetStatus()
+	require.Equal(t, int64(1), status.Failures)
+	require.Equal(t, "job panicked: custom panic", status.LastError)
+}
+
+func TestSchedulerStartStop(t *testing.T) {
+	t.Parallel()
+
+	scheduler, mock := getScheduler(t)
+
+	ran := make(chan bool, 1)
+	scheduler.Register("job", time.Hour, func() error {
+		ran <- true
+		return nil
+	})
+	job := scheduler.Jobs[0]
+
+	mock.ExpectQuery("SELECT pg_try_advisory_lock").
+		WithArgs(job.LockKey()).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+	mock.ExpectExec("SELECT pg_advisory_unlock").
+		WithArgs(job.LockKey()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.True(t, scheduler.Enabled())
+
+	go scheduler.Start()
+	<-ran
+	scheduler.Stop()
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Len(t, scheduler.GetJobsStatuses(), 1)
+	require.False(t, scheduler.GetJobsStatuses()[0].IsLeader)
+}
@@ -72,7 +72,7 @@ func (m *TelegramTemplatesManager) GetTemplate(templateName string) (*template.T
 		"FormatLink":       m.FormatLink,
 		"FormatLinks":      m.FormatLinks,
 		"SerializeAmount":  m.SerializeAmount,
-	}).ParseFS(templates.TemplatesFs, "telegram/"+filename)
+	}).ParseFS(templates.TemplatesFs, "telegram/"+filename, "telegram/partials/*.html")
 	if err != nil {
 		return nil, err
 	}
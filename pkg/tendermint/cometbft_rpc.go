@@ -0,0 +1,156 @@
+package tendermint
+
+import (
+	"encoding/json"
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/http"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"math/rand"
+	"strconv"
+
+	"github.com/rs/zerolog"
+)
+
+// CometBFTRPC queries the CometBFT RPC of the chain nodes, as opposed to RPC,
+// which queries the cosmos-sdk LCD.
+type CometBFTRPC struct {
+	Chain          *types.Chain
+	Client         *http.Client
+	Logger         zerolog.Logger
+	MetricsManager *metrics.Manager
+}
+
+func NewCometBFTRPC(
+	chain *types.Chain,
+	logger *zerolog.Logger,
+	metricsManager *metrics.Manager,
+) *CometBFTRPC {
+	return &CometBFTRPC{
+		Chain:  chain,
+		Client: http.NewClient(logger, chain.Name),
+		Logger: logger.With().
+			Str("component", "cometbft_rpc").
+			Str("chain", chain.Name).
+			Logger(),
+		MetricsManager: metricsManager,
+	}
+}
+
+func (rpc *CometBFTRPC) GetStatus(hosts []string) (*types.CometBFTStatus, error) {
+	var response types.CometBFTStatus
+	if err := rpc.Get(hosts, "/status", "cometbft_status", &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (rpc *CometBFTRPC) GetNetInfo(hosts []string) (*types.CometBFTNetInfo, error) {
+	var response types.CometBFTNetInfo
+	if err := rpc.Get(hosts, "/net_info", "cometbft_net_info", &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (rpc *CometBFTRPC) GetConsensusState(hosts []string) (*types.CometBFTConsensusState, error) {
+	var response types.CometBFTConsensusState
+	if err := rpc.Get(hosts, "/consensus_state", "cometbft_consensus_state", &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetValidators returns the active validator set at the given height,
+// fetching it page by page as the endpoint returns at most 100 validators per page.
+func (rpc *CometBFTRPC) GetValidators(hosts []string, height int64) ([]types.CometBFTValidator, error) {
+	validators := make([]types.CometBFTValidator, 0)
+
+	for page := 1; ; page++ {
+		url := fmt.Sprintf(
+			"/validators?height=%d&page=%d&per_page=%d",
+			height,
+			page,
+			constants.CometBFTValidatorsPerPage,
+		)
+
+		var response types.CometBFTValidators
+		if err := rpc.Get(hosts, url, "cometbft_validators", &response); err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, response.Validators...)
+
+		total, err := strconv.Atoi(response.Total)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(response.Validators) == 0 || len(validators) >= total {
+			return validators, nil
+		}
+	}
+}
+
+func (rpc *CometBFTRPC) Get(
+	hosts []string,
+	url string,
+	queryName string,
+	target interface{},
+) error {
+	for attempt := range constants.RetriesCount {
+		host := hosts[rand.Int()%len(hosts)]
+		queryInfo, err := rpc.GetOne(host, url, queryName, target)
+		rpc.MetricsManager.LogQueryInfo(queryInfo)
+
+		if err == nil {
+			return nil
+		}
+
+		rpc.Logger.Warn().
+			Str("host", host).
+			Str("url", url).
+			Int("attempt", attempt).
+			Int("max_attempts", constants.RetriesCount).
+			Err(err).
+			Msg("RPC request failed, retrying")
+	}
+
+	rpc.Logger.Error().
+		Strs("hosts", hosts).
+		Str("url", url).
+		Int("max_attempts", constants.RetriesCount).
+		Msg("All RPC requests failed")
+
+	return fmt.Errorf("could not get data after %d attempts", constants.RetriesCount)
+}
+
+func (rpc *CometBFTRPC) GetOne(
+	host string,
+	url string,
+	queryName string,
+	target interface{},
+) (types.QueryInfo, error) {
+	var response types.CometBFTResponse
+
+	queryInfo, err := rpc.Client.Get(host, url, queryName, &response)
+	if err != nil {
+		return queryInfo, err
+	}
+
+	if response.Error != nil {
+		queryInfo.Success = false
+		return queryInfo, response.Error
+	}
+
+	if err := json.Unmarshal(response.Result, target); err != nil {
+		queryInfo.Success = false
+		return queryInfo, err
+	}
+
+	return queryInfo, nil
+}
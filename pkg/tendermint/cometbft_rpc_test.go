@@ -0,0 +1,52 @@
+package tendermint
+
+import (
+	"main/assets"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:paralleltest // disabled
+func TestCometBFTRPCGetStatusOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.example.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("cometbft-status.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	rpc := NewCometBFTRPC(&types.Chain{Name: "chain"}, logger, metricsManager)
+
+	status, err := rpc.GetStatus([]string{"https://rpc.example.com"})
+	require.NoError(t, err)
+	require.Equal(t, "cosmoshub-4", status.NodeInfo.Network)
+	require.Equal(t, "23000000", status.SyncInfo.LatestBlockHeight)
+	require.False(t, status.SyncInfo.CatchingUp)
+}
+
+//nolint:paralleltest // disabled
+func TestCometBFTRPCGetStatusError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.example.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("cometbft-error.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	rpc := NewCometBFTRPC(&types.Chain{Name: "chain"}, logger, metricsManager)
+
+	status, err := rpc.GetStatus([]string{"https://rpc.example.com"})
+	require.Error(t, err)
+	require.Nil(t, status)
+}
@@ -0,0 +1,119 @@
+package tendermint
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"strings"
+
+	queryTypes "github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+type PaginatedResponse interface {
+	proto.Message
+	GetPagination() *queryTypes.PageResponse
+}
+
+func (rpc *RPC) GetPaginatedURL(baseURL string, nextKey []byte) string {
+	separator := "?"
+	if strings.Contains(baseURL, "?") {
+		separator = "&"
+	}
+
+	paginatedURL := baseURL + separator + "pagination.limit=" + strconv.FormatUint(rpc.PaginationConfig.PageSize, 10)
+
+	if len(nextKey) > 0 {
+		paginatedURL += "&pagination.key=" + url.QueryEscape(base64.StdEncoding.EncodeToString(nextKey))
+	}
+
+	return paginatedURL
+}
+
+type PaginatedJSONResponse interface {
+	GetNextKey() []byte
+}
+
+// GetAllPages queries a paginated LCD endpoint page by page, following
+// pagination.next_key until it's empty or the max pages limit is reached.
+// It's a function and not a method as Go methods cannot have type parameters.
+func GetAllPages[T any, PT interface {
+	*T
+	PaginatedResponse
+}](
+	rpc *RPC,
+	hosts []string,
+	baseURL string,
+	queryName string,
+) ([]PT, error) {
+	return getAllPages(rpc, baseURL, func(url string) (PT, []byte, error) {
+		response := PT(new(T))
+		if err := rpc.Get(hosts, url, queryName, response); err != nil {
+			return nil, nil, err
+		}
+
+		if pagination := response.GetPagination(); pagination != nil {
+			return response, pagination.NextKey, nil
+		}
+
+		return response, nil, nil
+	})
+}
+
+// GetAllPagesJSON is the same as GetAllPages, but for responses that are decoded
+// as plain JSON and not via the codec.
+func GetAllPagesJSON[T any, PT interface {
+	*T
+	PaginatedJSONResponse
+}](
+	rpc *RPC,
+	hosts []string,
+	baseURL string,
+	queryName string,
+) ([]PT, error) {
+	return getAllPages(rpc, baseURL, func(url string) (PT, []byte, error) {
+		response := PT(new(T))
+		if err := rpc.GetJSON(hosts, url, queryName, response); err != nil {
+			return nil, nil, err
+		}
+
+		return response, response.GetNextKey(), nil
+	})
+}
+
+func getAllPages[P any](
+	rpc *RPC,
+	baseURL string,
+	getPage func(url string) (P, []byte, error),
+) ([]P, error) {
+	pages := make([]P, 0)
+	var nextKey []byte
+
+	for page := range rpc.PaginationConfig.MaxPages {
+		response, pageNextKey, err := getPage(rpc.GetPaginatedURL(baseURL, nextKey))
+		if err != nil {
+			return nil, err
+		}
+
+		pages = append(pages, response)
+
+		if len(pageNextKey) == 0 {
+			return pages, nil
+		}
+
+		nextKey = pageNextKey
+
+		rpc.Logger.Trace().
+			Str("url", baseURL).
+			Int("page", page).
+			Msg("Got a page, fetching the next one")
+	}
+
+	rpc.Logger.Warn().
+		Str("url", baseURL).
+		Int("max_pages", rpc.PaginationConfig.MaxPages).
+		Uint64("page_size", rpc.PaginationConfig.PageSize).
+		Msg("Reached max pages limit, the response might be incomplete")
+
+	return pages, nil
+}
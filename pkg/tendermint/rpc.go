@@ -11,13 +11,18 @@ import (
 	"main/pkg/types"
 	"main/pkg/utils"
 	"math/rand"
+	neturl "net/url"
 	"strconv"
 	"strings"
 	"time"
 
 	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
 
+	upgradeTypes "cosmossdk.io/x/upgrade/types"
 	cmtservice "github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	nodeTypes "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributionTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -27,31 +32,39 @@ import (
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cosmos/gogoproto/proto"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/rs/zerolog"
 )
 
 type RPC struct {
-	Chain          *types.Chain
-	Host           string
-	Client         *http.Client
-	Timeout        int
-	Logger         zerolog.Logger
-	MetricsManager *metrics.Manager
-	Converter      *converterPkg.Converter
+	Chain            *types.Chain
+	Host             string
+	Client           *http.Client
+	Timeout          int
+	PaginationConfig types.PaginationConfig
+	Logger           zerolog.Logger
+	MetricsManager   *metrics.Manager
+	Converter        *converterPkg.Converter
+
+	// requests coalescing, so identical concurrent LCD queries
+	// are only executed once and their result is shared
+	group singleflight.Group
 }
 
 func NewRPC(
 	chain *types.Chain,
 	timeout int,
+	paginationConfig types.PaginationConfig,
 	logger *zerolog.Logger,
 	converter *converterPkg.Converter,
 	metricsManager *metrics.Manager,
 ) *RPC {
 	return &RPC{
-		Chain:   chain,
-		Client:  http.NewClient(logger, chain.Name),
-		Timeout: timeout,
+		Chain:            chain,
+		Client:           http.NewClient(logger, chain.Name),
+		Timeout:          timeout,
+		PaginationConfig: paginationConfig,
 		Logger: logger.With().
 			Str("component", "rpc").
 			Str("chain", chain.Name).
@@ -62,27 +75,35 @@ func NewRPC(
 }
 
 func (rpc *RPC) GetAllValidators(hosts []string) (*stakingTypes.QueryValidatorsResponse, error) {
-	url := "/cosmos/staking/v1beta1/validators?pagination.count_total=true&pagination.limit=1000"
+	url := "/cosmos/staking/v1beta1/validators"
 
-	var response stakingTypes.QueryValidatorsResponse
-	err := rpc.Get(hosts, url, "validators", &response)
+	pages, err := GetAllPages[stakingTypes.QueryValidatorsResponse](rpc, hosts, url, "validators")
 	if err != nil {
 		return nil, err
 	}
 
-	return &response, nil
+	response := &stakingTypes.QueryValidatorsResponse{}
+	for _, page := range pages {
+		response.Validators = append(response.Validators, page.Validators...)
+	}
+
+	return response, nil
 }
 
 func (rpc *RPC) GetAllSigningInfos(hosts []string) (*slashingTypes.QuerySigningInfosResponse, error) {
-	url := "/cosmos/slashing/v1beta1/signing_infos?pagination.limit=1000"
+	url := "/cosmos/slashing/v1beta1/signing_infos"
 
-	var response slashingTypes.QuerySigningInfosResponse
-	err := rpc.Get(hosts, url, "signing_infos", &response)
+	pages, err := GetAllPages[slashingTypes.QuerySigningInfosResponse](rpc, hosts, url, "signing_infos")
 	if err != nil {
 		return nil, err
 	}
 
-	return &response, nil
+	response := &slashingTypes.QuerySigningInfosResponse{}
+	for _, page := range pages {
+		response.Info = append(response.Info, page.Info...)
+	}
+
+	return response, nil
 }
 
 func (rpc *RPC) GetValidator(address string, hosts []string) (*stakingTypes.QueryValidatorResponse, error) {
@@ -169,6 +190,49 @@ func (rpc *RPC) GetBalance(address string, hosts []string) (*bankTypes.QueryAllB
 	return &response, nil
 }
 
+func (rpc *RPC) GetSpendableBalances(address string, hosts []string) (*bankTypes.QuerySpendableBalancesResponse, error) {
+	url := "/cosmos/bank/v1beta1/spendable_balances/" + address
+
+	var response bankTypes.QuerySpendableBalancesResponse
+	err := rpc.Get(hosts, url, "spendable_balances", &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (rpc *RPC) GetAccount(address string, hosts []string) (*authTypes.QueryAccountResponse, error) {
+	url := "/cosmos/auth/v1beta1/accounts/" + address
+
+	// if the account type is not known, returning only its type,
+	// so the rest of the account info, like balances, can still be shown
+	var response authTypes.QueryAccountResponse
+	err := rpc.GetWithDecoder(hosts, url, "account", func(bytes []byte) error {
+		decodeErr := rpc.Converter.Unmarshal(bytes, &response)
+		if decodeErr == nil {
+			return nil
+		}
+
+		rpc.Logger.Debug().Err(decodeErr).Msg("Could not decode account, getting its type only")
+
+		var rawResponse types.RawAccountResponse
+		if err := json.Unmarshal(bytes, &rawResponse); err != nil {
+			return err
+		}
+
+		response = authTypes.QueryAccountResponse{
+			Account: &codecTypes.Any{TypeUrl: rawResponse.Account.Type},
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 func (rpc *RPC) GetRewards(address string, hosts []string) (*distributionTypes.QueryDelegationTotalRewardsResponse, error) {
 	url := "/cosmos/distribution/v1beta1/delegators/" + address + "/rewards"
 
@@ -203,39 +267,67 @@ func (rpc *RPC) GetCommission(address string, hosts []string) (*distributionType
 }
 
 func (rpc *RPC) GetDelegations(address string, hosts []string) (*stakingTypes.QueryDelegatorDelegationsResponse, error) {
-	url := "/cosmos/staking/v1beta1/delegations/" + address + "?pagination.limit=1000"
+	url := "/cosmos/staking/v1beta1/delegations/" + address
 
-	var response stakingTypes.QueryDelegatorDelegationsResponse
-	err := rpc.Get(hosts, url, "delegations", &response)
+	pages, err := GetAllPages[stakingTypes.QueryDelegatorDelegationsResponse](rpc, hosts, url, "delegations")
 	if err != nil {
 		return nil, err
 	}
 
-	return &response, nil
+	response := &stakingTypes.QueryDelegatorDelegationsResponse{}
+	for _, page := range pages {
+		response.DelegationResponses = append(response.DelegationResponses, page.DelegationResponses...)
+	}
+
+	return response, nil
+}
+
+// GetDelegation returns the delegator's delegation to the validator, or nil if there's none.
+func (rpc *RPC) GetDelegation(validator, delegator string, hosts []string) (*stakingTypes.DelegationResponse, error) {
+	url := "/cosmos/staking/v1beta1/validators/" + validator + "/delegations/" + delegator
+
+	var response stakingTypes.QueryDelegationResponse
+	if err := rpc.Get(hosts, url, "delegation", &response); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return response.DelegationResponse, nil
 }
 
 func (rpc *RPC) GetRedelegations(address string, hosts []string) (*stakingTypes.QueryRedelegationsResponse, error) {
-	url := "/cosmos/staking/v1beta1/delegators/" + address + "/redelegations?pagination.limit=1000"
+	url := "/cosmos/staking/v1beta1/delegators/" + address + "/redelegations"
 
-	var response stakingTypes.QueryRedelegationsResponse
-	err := rpc.Get(hosts, url, "commission", &response)
+	pages, err := GetAllPages[stakingTypes.QueryRedelegationsResponse](rpc, hosts, url, "redelegations")
 	if err != nil {
 		return nil, err
 	}
 
-	return &response, nil
+	response := &stakingTypes.QueryRedelegationsResponse{}
+	for _, page := range pages {
+		response.RedelegationResponses = append(response.RedelegationResponses, page.RedelegationResponses...)
+	}
+
+	return response, nil
 }
 
 func (rpc *RPC) GetUnbonds(address string, hosts []string) (*stakingTypes.QueryDelegatorUnbondingDelegationsResponse, error) {
-	url := "/cosmos/staking/v1beta1/delegators/" + address + "/unbonding_delegations?pagination.limit=1000"
+	url := "/cosmos/staking/v1beta1/delegators/" + address + "/unbonding_delegations"
 
-	var response stakingTypes.QueryDelegatorUnbondingDelegationsResponse
-	err := rpc.Get(hosts, url, "unbonds", &response)
+	pages, err := GetAllPages[stakingTypes.QueryDelegatorUnbondingDelegationsResponse](rpc, hosts, url, "unbonds")
 	if err != nil {
 		return nil, err
 	}
 
-	return &response, nil
+	response := &stakingTypes.QueryDelegatorUnbondingDelegationsResponse{}
+	for _, page := range pages {
+		response.UnbondingResponses = append(response.UnbondingResponses, page.UnbondingResponses...)
+	}
+
+	return response, nil
 }
 
 func (rpc *RPC) GetPool(hosts []string) (*stakingTypes.QueryPoolResponse, error) {
@@ -251,10 +343,38 @@ func (rpc *RPC) GetPool(hosts []string) (*stakingTypes.QueryPoolResponse, error)
 }
 
 func (rpc *RPC) GetSupply(hosts []string) (*bankTypes.QueryTotalSupplyResponse, error) {
-	url := "/cosmos/bank/v1beta1/supply?pagination.limit=10000&pagination.offset=0"
+	url := "/cosmos/bank/v1beta1/supply"
+
+	pages, err := GetAllPages[bankTypes.QueryTotalSupplyResponse](rpc, hosts, url, "supply")
+	if err != nil {
+		return nil, err
+	}
+
+	response := &bankTypes.QueryTotalSupplyResponse{}
+	for _, page := range pages {
+		response.Supply = append(response.Supply, page.Supply...)
+	}
 
-	var response bankTypes.QueryTotalSupplyResponse
-	err := rpc.Get(hosts, url, "supply", &response)
+	return response, nil
+}
+
+func (rpc *RPC) GetSupplyOf(denom string, hosts []string) (*bankTypes.QuerySupplyOfResponse, error) {
+	url := "/cosmos/bank/v1beta1/supply/by_denom?denom=" + neturl.QueryEscape(denom)
+
+	var response bankTypes.QuerySupplyOfResponse
+	err := rpc.Get(hosts, url, "supply_of", &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (rpc *RPC) GetDistributionParams(hosts []string) (*distributionTypes.QueryParamsResponse, error) {
+	url := "/cosmos/distribution/v1beta1/params"
+
+	var response distributionTypes.QueryParamsResponse
+	err := rpc.Get(hosts, url, "distribution_params", &response)
 	if err != nil {
 		return nil, err
 	}
@@ -262,6 +382,98 @@ func (rpc *RPC) GetSupply(hosts []string) (*bankTypes.QueryTotalSupplyResponse,
 	return &response, nil
 }
 
+func (rpc *RPC) GetNodeConfig(hosts []string) (*nodeTypes.ConfigResponse, error) {
+	url := "/cosmos/base/node/v1beta1/config"
+
+	var response nodeTypes.ConfigResponse
+	err := rpc.Get(hosts, url, "node_config", &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetTxs returns a page of the latest txs matching all the given events, like "message.sender='address'".
+// Cosmos SDK v0.50 takes them as a single query joined with AND, while older versions
+// only accept an events param per condition and reject requests without it, so these
+// are queried if the query is rejected.
+func (rpc *RPC) GetTxs(hosts []string, events []string, limit int, page int) (*types.TxsResponse, error) {
+	url := fmt.Sprintf(
+		"/cosmos/tx/v1beta1/txs?query=%s&order_by=ORDER_BY_DESC&limit=%d&page=%d",
+		neturl.QueryEscape(strings.Join(events, " AND ")),
+		limit,
+		page,
+	)
+
+	var response types.TxsResponse
+	err := rpc.GetJSON(hosts, url, "txs", &response)
+	if err == nil {
+		return &response, nil
+	}
+
+	var lcdErr types.LCDError
+	if !errors.As(err, &lcdErr) {
+		return nil, err
+	}
+
+	rpc.Logger.Debug().Err(err).Msg("Txs query is not supported, trying events")
+
+	eventsParams := utils.Map(events, func(event string) string {
+		return "events=" + neturl.QueryEscape(event)
+	})
+
+	url = fmt.Sprintf(
+		"/cosmos/tx/v1beta1/txs?%s&order_by=ORDER_BY_DESC&limit=%d&page=%d",
+		strings.Join(eventsParams, "&"),
+		limit,
+		page,
+	)
+
+	var responseEvents types.TxsResponse
+	if err := rpc.GetJSON(hosts, url, "txs_events", &responseEvents); err != nil {
+		return nil, err
+	}
+
+	return &responseEvents, nil
+}
+
+// GetAllTxs returns all the txs matching the given events, fetching them page by page
+// until the total count is reached or the max pages limit is reached.
+func (rpc *RPC) GetAllTxs(hosts []string, events []string, limit int) (*types.TxsResponse, error) {
+	response := &types.TxsResponse{}
+
+	for page := 1; page <= rpc.PaginationConfig.MaxPages; page++ {
+		pageResponse, err := rpc.GetTxs(hosts, events, limit, page)
+		if err != nil {
+			return nil, err
+		}
+
+		response.TxResponses = append(response.TxResponses, pageResponse.TxResponses...)
+		response.Total = pageResponse.Total
+
+		// some nodes do not return the total count, so a page not full is the last one
+		if len(pageResponse.TxResponses) < limit || uint64(len(response.TxResponses)) >= pageResponse.Total {
+			return response, nil
+		}
+
+		rpc.Logger.Trace().
+			Strs("events", events).
+			Int("page", page).
+			Uint64("total", pageResponse.Total).
+			Msg("Got a txs page, fetching the next one")
+	}
+
+	rpc.Logger.Warn().
+		Strs("events", events).
+		Int("max_pages", rpc.PaginationConfig.MaxPages).
+		Int("limit", limit).
+		Uint64("total", response.Total).
+		Msg("Reached max pages limit, the txs might be incomplete")
+
+	return response, nil
+}
+
 func (rpc *RPC) GetCommunityPool(hosts []string) (*distributionTypes.QueryCommunityPoolResponse, error) {
 	url := "/cosmos/distribution/v1beta1/community_pool?pagination.limit=10000&pagination.offset=0"
 
@@ -274,6 +486,15 @@ func (rpc *RPC) GetCommunityPool(hosts []string) (*distributionTypes.QueryCommun
 	return &response, nil
 }
 
+func (rpc *RPC) GetLatestBlock(hosts []string) (*cmtservice.GetLatestBlockResponse, error) {
+	var response cmtservice.GetLatestBlockResponse
+	if err := rpc.Get(hosts, "/cosmos/base/tendermint/v1beta1/blocks/latest", "block", &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 func (rpc *RPC) GetBlockTime(hosts []string) (time.Duration, error) {
 	var newerBlock cmtservice.GetLatestBlockResponse
 	err := rpc.Get(hosts, "/cosmos/base/tendermint/v1beta1/blocks/latest", "block", &newerBlock)
@@ -301,12 +522,40 @@ func (rpc *RPC) GetBlockTime(hosts []string) (time.Duration, error) {
 }
 
 func (rpc *RPC) GetActiveProposals(hosts []string) ([]*types.Proposal, error) {
-	url := "/cosmos/gov/v1/proposals?pagination.limit=1000&proposal_status=PROPOSAL_STATUS_VOTING_PERIOD"
+	return rpc.GetProposals(hosts, govV1Types.StatusVotingPeriod)
+}
+
+// GetProposals returns all proposals with the given status,
+// or all proposals if the status is unspecified. Proposals are decoded one by one,
+// and the ones with messages of types we cannot decode, like IBC or wasm ones,
+// are skipped, instead of failing the whole query.
+func (rpc *RPC) GetProposals(hosts []string, status govV1Types.ProposalStatus) ([]*types.Proposal, error) {
+	url := "/cosmos/gov/v1/proposals"
+	if status != govV1Types.StatusNil {
+		url += "?proposal_status=" + status.String()
+	}
 
-	var response govV1Types.QueryProposalsResponse
-	err := rpc.Get(hosts, url, "proposals_v1", &response)
+	pages, err := GetAllPagesJSON[types.RawProposalsResponse](rpc, hosts, url, "proposals_v1")
 	if err == nil {
-		return utils.Map(response.Proposals, types.ProposalFromV1), nil
+		proposals := make([]*types.Proposal, 0)
+		for _, page := range pages {
+			for _, rawProposal := range page.Proposals {
+				var proposal govV1Types.Proposal
+				if err := rpc.Converter.Unmarshal(rawProposal, &proposal); err != nil {
+					rpc.Logger.Warn().Err(err).Msg("Could not decode proposal, skipping")
+					continue
+				}
+
+				if err := rpc.Converter.UnpackProposalV1(&proposal); err != nil {
+					rpc.Logger.Warn().Uint64("proposal_id", proposal.Id).Err(err).Msg("Could not decode proposal, skipping")
+					continue
+				}
+
+				proposals = append(proposals, types.ProposalFromV1(&proposal))
+			}
+		}
+
+		return proposals, nil
 	}
 
 	if !strings.Contains(err.Error(), "Not Implemented") {
@@ -315,21 +564,97 @@ func (rpc *RPC) GetActiveProposals(hosts []string) ([]*types.Proposal, error) {
 
 	rpc.Logger.Warn().Msg("v1 proposals are not supported, falling back to v1beta1")
 
-	url = "/cosmos/gov/v1beta1/proposals?pagination.limit=1000&proposal_status=2"
+	// v1beta1 statuses have the same values as v1 ones, but are passed as numbers.
+	url = "/cosmos/gov/v1beta1/proposals"
+	if status != govV1Types.StatusNil {
+		url += "?proposal_status=" + strconv.Itoa(int(status))
+	}
 
-	var responsev1beta1 govV1beta1Types.QueryProposalsResponse
-	err = rpc.Get(hosts, url, "proposals_v1beta1", &responsev1beta1)
+	pagesv1beta1, err := GetAllPagesJSON[types.RawProposalsResponse](rpc, hosts, url, "proposals_v1beta1")
 	if err != nil {
 		return nil, err
 	}
 
-	for _, proposal := range responsev1beta1.Proposals {
-		if err := rpc.Converter.UnpackProposal(proposal); err != nil {
+	proposals := make([]*types.Proposal, 0)
+
+	for _, page := range pagesv1beta1 {
+		for _, rawProposal := range page.Proposals {
+			var proposal govV1beta1Types.Proposal
+			if err := rpc.Converter.Unmarshal(rawProposal, &proposal); err != nil {
+				rpc.Logger.Warn().Err(err).Msg("Could not decode proposal, skipping")
+				continue
+			}
+
+			if err := rpc.Converter.UnpackProposal(proposal); err != nil {
+				rpc.Logger.Warn().Uint64("proposal_id", proposal.ProposalId).Err(err).Msg("Could not decode proposal, skipping")
+				continue
+			}
+
+			proposals = append(proposals, types.ProposalFromV1beta1(proposal))
+		}
+	}
+
+	return proposals, nil
+}
+
+func (rpc *RPC) GetCurrentUpgradePlan(hosts []string) (*upgradeTypes.QueryCurrentPlanResponse, error) {
+	var response upgradeTypes.QueryCurrentPlanResponse
+	if err := rpc.Get(hosts, "/cosmos/upgrade/v1beta1/current_plan", "upgrade_current_plan", &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetPassedUpgradePlans returns the upgrade plans from all passed proposals,
+// including the already executed ones. Proposals are decoded as plain JSON and only
+// their upgrade plans are decoded via the codec, as other messages can be of types
+// we cannot decode, like IBC or wasm ones.
+func (rpc *RPC) GetPassedUpgradePlans(hosts []string) ([]*types.UpgradePlan, error) {
+	url := "/cosmos/gov/v1/proposals?proposal_status=PROPOSAL_STATUS_PASSED"
+
+	pages, err := GetAllPagesJSON[types.RawProposalsResponse](rpc, hosts, url, "proposals_passed_v1")
+	if err != nil {
+		if !strings.Contains(err.Error(), "Not Implemented") {
+			return nil, err
+		}
+
+		rpc.Logger.Warn().Msg("v1 proposals are not supported, falling back to v1beta1")
+
+		url = "/cosmos/gov/v1beta1/proposals?proposal_status=3"
+
+		pages, err = GetAllPagesJSON[types.RawProposalsResponse](rpc, hosts, url, "proposals_passed_v1beta1")
+		if err != nil {
 			return nil, err
 		}
 	}
 
-	return utils.Map(responsev1beta1.Proposals, types.ProposalFromV1beta1), nil
+	plans := make([]*types.UpgradePlan, 0)
+
+	for _, page := range pages {
+		for _, rawProposal := range page.Proposals {
+			var proposal types.RawUpgradeProposal
+			if err := json.Unmarshal(rawProposal, &proposal); err != nil {
+				rpc.Logger.Warn().Err(err).Msg("Could not decode proposal, skipping")
+				continue
+			}
+
+			for _, rawPlan := range proposal.GetRawPlans() {
+				var plan upgradeTypes.Plan
+				if err := rpc.Converter.Unmarshal(rawPlan, &plan); err != nil {
+					rpc.Logger.Warn().
+						Str("proposal_id", proposal.GetID()).
+						Err(err).
+						Msg("Could not decode proposal upgrade plan, skipping")
+					continue
+				}
+
+				plans = append(plans, types.UpgradePlanFrom(plan, proposal.GetID()))
+			}
+		}
+	}
+
+	return plans, nil
 }
 
 func (rpc *RPC) GetSingleProposal(proposalID string, hosts []string) (*types.Proposal, error) {
@@ -338,6 +663,10 @@ func (rpc *RPC) GetSingleProposal(proposalID string, hosts []string) (*types.Pro
 	var response govV1Types.QueryProposalResponse
 	err := rpc.Get(hosts, url, "proposal_v1", &response)
 	if err == nil {
+		if err := rpc.Converter.UnpackProposalV1(response.Proposal); err != nil {
+			return nil, err
+		}
+
 		return types.ProposalFromV1(response.Proposal), nil
 	}
 
@@ -371,18 +700,144 @@ func (rpc *RPC) GetSingleProposal(proposalID string, hosts []string) (*types.Pro
 	return types.ProposalFromV1beta1(responsev1beta1.Proposal), nil
 }
 
+func (rpc *RPC) GetProposalTally(proposalID string, hosts []string) (*types.ProposalTally, error) {
+	url := "/cosmos/gov/v1/proposals/" + proposalID + "/tally"
+
+	var response govV1Types.QueryTallyResultResponse
+	err := rpc.Get(hosts, url, "proposal_tally_v1", &response)
+	if err == nil {
+		if response.Tally == nil {
+			return nil, errors.New("proposal tally is empty")
+		}
+
+		return types.ProposalTallyFromV1(response.Tally)
+	}
+
+	if !strings.Contains(err.Error(), "Not Implemented") {
+		return nil, err
+	}
+
+	rpc.Logger.Warn().Msg("v1 proposal tally is not supported, falling back to v1beta1")
+
+	url = "/cosmos/gov/v1beta1/proposals/" + proposalID + "/tally"
+
+	var responsev1beta1 govV1beta1Types.QueryTallyResultResponse
+	if err := rpc.Get(hosts, url, "proposal_tally_v1beta1", &responsev1beta1); err != nil {
+		return nil, err
+	}
+
+	return types.ProposalTallyFromV1beta1(responsev1beta1.Tally), nil
+}
+
+// GetProposalVote returns how the voter has voted on the proposal, or nil if it hasn't voted.
+func (rpc *RPC) GetProposalVote(proposalID, voter string, hosts []string) (*types.ProposalVote, error) {
+	url := "/cosmos/gov/v1/proposals/" + proposalID + "/votes/" + voter
+
+	var response govV1Types.QueryVoteResponse
+	err := rpc.Get(hosts, url, "proposal_vote_v1", &response)
+	if err == nil {
+		if response.Vote == nil {
+			return nil, nil
+		}
+
+		return types.ProposalVoteFromV1(response.Vote)
+	}
+
+	if strings.Contains(err.Error(), "not found") {
+		return nil, nil
+	}
+
+	if !strings.Contains(err.Error(), "Not Implemented") {
+		return nil, err
+	}
+
+	rpc.Logger.Warn().Msg("v1 proposal vote is not supported, falling back to v1beta1")
+
+	url = "/cosmos/gov/v1beta1/proposals/" + proposalID + "/votes/" + voter
+
+	var responsev1beta1 govV1beta1Types.QueryVoteResponse
+	if err := rpc.Get(hosts, url, "proposal_vote_v1beta1", &responsev1beta1); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return types.ProposalVoteFromV1beta1(responsev1beta1.Vote), nil
+}
+
 func (rpc *RPC) Get(
 	hosts []string,
 	url string,
 	queryName string,
 	target proto.Message,
 ) error {
+	return rpc.GetWithDecoder(hosts, url, queryName, func(bytes []byte) error {
+		return rpc.Converter.Unmarshal(bytes, target)
+	})
+}
+
+// GetJSON is used for querying endpoints that return non-protobuf responses,
+// or responses the types of which are not available, like custom chain modules.
+func (rpc *RPC) GetJSON(
+	hosts []string,
+	url string,
+	queryName string,
+	target interface{},
+) error {
+	return rpc.GetWithDecoder(hosts, url, queryName, func(bytes []byte) error {
+		return json.Unmarshal(bytes, target)
+	})
+}
+
+func (rpc *RPC) GetWithDecoder(
+	hosts []string,
+	url string,
+	queryName string,
+	decode func(bytes []byte) error,
+) error {
+	isLeader := false
+
+	bytes, err, _ := rpc.group.Do(rpc.Chain.Name+url, func() (interface{}, error) {
+		isLeader = true
+		return rpc.GetWithRetries(hosts, url, queryName, decode)
+	})
+	if err != nil {
+		return err
+	}
+
+	// the request was executed by this caller and the target is already populated
+	if isLeader {
+		return nil
+	}
+
+	rpc.MetricsManager.LogDeduplicatedQuery(rpc.Chain.Name, queryName)
+
+	rpc.Logger.Trace().
+		Str("url", url).
+		Msg("Reusing the response of an in-flight LCD request")
+
+	responseBytes, _ := bytes.([]byte)
+	return decode(responseBytes)
+}
+
+func (rpc *RPC) GetWithRetries(
+	hosts []string,
+	url string,
+	queryName string,
+	decode func(bytes []byte) error,
+) ([]byte, error) {
+	var lastErr error
+
 	for attempt := range constants.RetriesCount {
 		host := hosts[rand.Int()%len(hosts)]
-		queryInfo, err := rpc.GetOne(host, url, queryName, target)
+		bytes, queryInfo, err := rpc.GetOne(host, url, queryName, decode)
 		rpc.MetricsManager.LogQueryInfo(queryInfo)
 
 		if err != nil {
+			lastErr = err
+
 			rpc.Logger.Warn().
 				Str("host", host).
 				Str("url", url).
@@ -391,7 +846,7 @@ func (rpc *RPC) Get(
 				Err(err).
 				Msg("LCD request failed, retrying")
 		} else {
-			return nil
+			return bytes, nil
 		}
 	}
 
@@ -401,15 +856,22 @@ func (rpc *RPC) Get(
 		Int("max_attempts", constants.RetriesCount).
 		Msg("All LCD requests failed")
 
-	return fmt.Errorf("could not get data after %d attempts", constants.RetriesCount)
+	// If the node has responded with an error, like when the endpoint is not implemented,
+	// returning it as is, so callers can check for it, like to fall back to older endpoints.
+	var lcdErr types.LCDError
+	if errors.As(lastErr, &lcdErr) {
+		return nil, lcdErr
+	}
+
+	return nil, fmt.Errorf("could not get data after %d attempts", constants.RetriesCount)
 }
 
 func (rpc *RPC) GetOne(
 	host string,
 	url string,
 	queryName string,
-	target proto.Message,
-) (types.QueryInfo, error) {
+	decode func(bytes []byte) error,
+) ([]byte, types.QueryInfo, error) {
 	bytes, queryInfo, err := rpc.Client.GetPlain(
 		host,
 		url,
@@ -420,7 +882,7 @@ func (rpc *RPC) GetOne(
 			Str("host", host).
 			Str("url", url).
 			Err(err).Msg("LCD request failed")
-		return queryInfo, err
+		return nil, queryInfo, err
 	}
 
 	// check whether the response is error first
@@ -437,15 +899,15 @@ func (rpc *RPC) GetOne(
 				Str("message", errorResponse.Message).
 				Msg("LCD request returned an error")
 			queryInfo.Success = false
-			return queryInfo, errors.New(errorResponse.Message)
+			return nil, queryInfo, errorResponse
 		}
 	}
 
-	if decodeErr := rpc.Converter.Unmarshal(bytes, target); decodeErr != nil {
+	if decodeErr := decode(bytes); decodeErr != nil {
 		rpc.Logger.Warn().Str("url", url).Err(decodeErr).Msg("JSON unmarshalling failed")
 		queryInfo.Success = false
-		return queryInfo, decodeErr
+		return nil, queryInfo, decodeErr
 	}
 
-	return queryInfo, nil
+	return bytes, queryInfo, nil
 }
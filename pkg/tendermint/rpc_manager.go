@@ -9,10 +9,15 @@ import (
 	"sync"
 	"time"
 
+	upgradeTypes "cosmossdk.io/x/upgrade/types"
+	cmtservice "github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	nodeTypes "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distributionTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govV1beta1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
@@ -24,27 +29,34 @@ import (
 )
 
 type NodeManager struct {
-	Logger         zerolog.Logger
-	Database       *databasePkg.Database
-	Converter      *converterPkg.Converter
-	MetricsManager *metrics.Manager
-	RPCs           map[string]*RPC
+	Logger           zerolog.Logger
+	PaginationConfig types.PaginationConfig
+	Database         *databasePkg.Database
+	Converter        *converterPkg.Converter
+	MetricsManager   *metrics.Manager
+	RPCs             map[string]*RPC
+	CometBFTRPCs     map[string]*CometBFTRPC
+	Websockets       *WebsocketManager
 
 	mutex sync.Mutex
 }
 
 func NewNodeManager(
 	logger *zerolog.Logger,
+	paginationConfig types.PaginationConfig,
 	database *databasePkg.Database,
 	converter *converterPkg.Converter,
 	metricsManager *metrics.Manager,
 ) *NodeManager {
 	return &NodeManager{
-		Logger:         logger.With().Str("component", "node_manager").Logger(),
-		Database:       database,
-		Converter:      converter,
-		MetricsManager: metricsManager,
-		RPCs:           map[string]*RPC{},
+		Logger:           logger.With().Str("component", "node_manager").Logger(),
+		PaginationConfig: paginationConfig,
+		Database:         database,
+		Converter:        converter,
+		MetricsManager:   metricsManager,
+		RPCs:             map[string]*RPC{},
+		CometBFTRPCs:     map[string]*CometBFTRPC{},
+		Websockets:       NewWebsocketManager(logger, database, metricsManager),
 	}
 }
 
@@ -59,6 +71,7 @@ func (manager *NodeManager) GetRPC(chain *types.Chain) *RPC {
 	rpc := NewRPC(
 		chain,
 		constants.RPCQueryTimeout,
+		manager.PaginationConfig,
 		&manager.Logger,
 		manager.Converter,
 		manager.MetricsManager,
@@ -67,6 +80,82 @@ func (manager *NodeManager) GetRPC(chain *types.Chain) *RPC {
 	return rpc
 }
 
+func (manager *NodeManager) GetCometBFTRPC(chain *types.Chain) *CometBFTRPC {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	if rpc, ok := manager.CometBFTRPCs[chain.Name]; ok {
+		return rpc
+	}
+
+	rpc := NewCometBFTRPC(chain, &manager.Logger, manager.MetricsManager)
+	manager.CometBFTRPCs[chain.Name] = rpc
+	return rpc
+}
+
+// Subscribe calls the handler on every websocket event matching the query
+// on this chain, like tm.event='NewBlock'. Requires the chain to have RPC nodes.
+func (manager *NodeManager) Subscribe(chain *types.Chain, query string, handler EventHandler) {
+	manager.Websockets.Subscribe(chain, query, handler)
+}
+
+func (manager *NodeManager) GetRPCNodes(chain *types.Chain) ([]string, error) {
+	hosts, err := manager.Database.GetRPCNodes(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hosts) == 0 {
+		return nil, constants.ErrNoRPCNodes
+	}
+
+	return hosts, nil
+}
+
+func (manager *NodeManager) GetStatus(chain *types.Chain) (*types.CometBFTStatus, error) {
+	hosts, err := manager.GetRPCNodes(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetCometBFTRPC(chain)
+	response, err := rpc.GetStatus(hosts)
+	return response, err
+}
+
+func (manager *NodeManager) GetNetInfo(chain *types.Chain) (*types.CometBFTNetInfo, error) {
+	hosts, err := manager.GetRPCNodes(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetCometBFTRPC(chain)
+	response, err := rpc.GetNetInfo(hosts)
+	return response, err
+}
+
+func (manager *NodeManager) GetConsensusState(chain *types.Chain) (*types.CometBFTConsensusState, error) {
+	hosts, err := manager.GetRPCNodes(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetCometBFTRPC(chain)
+	response, err := rpc.GetConsensusState(hosts)
+	return response, err
+}
+
+func (manager *NodeManager) GetCometBFTValidators(chain *types.Chain, height int64) ([]types.CometBFTValidator, error) {
+	hosts, err := manager.GetRPCNodes(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetCometBFTRPC(chain)
+	response, err := rpc.GetValidators(hosts, height)
+	return response, err
+}
+
 func (manager *NodeManager) GetAllValidators(chain *types.Chain) (*stakingTypes.QueryValidatorsResponse, error) {
 	hosts, err := manager.Database.GetLCDHosts(chain)
 	if err != nil {
@@ -166,6 +255,28 @@ func (manager *NodeManager) GetBalance(chain *types.Chain, address string) (*ban
 	return response, err
 }
 
+func (manager *NodeManager) GetSpendableBalances(chain *types.Chain, address string) (*bankTypes.QuerySpendableBalancesResponse, error) {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetRPC(chain)
+	response, err := rpc.GetSpendableBalances(address, hosts)
+	return response, err
+}
+
+func (manager *NodeManager) GetAccount(chain *types.Chain, address string) (*authTypes.QueryAccountResponse, error) {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetRPC(chain)
+	response, err := rpc.GetAccount(address, hosts)
+	return response, err
+}
+
 func (manager *NodeManager) GetRewards(chain *types.Chain, address string) (*distributionTypes.QueryDelegationTotalRewardsResponse, error) {
 	hosts, err := manager.Database.GetLCDHosts(chain)
 	if err != nil {
@@ -199,6 +310,21 @@ func (manager *NodeManager) GetDelegations(chain *types.Chain, address string) (
 	return response, err
 }
 
+func (manager *NodeManager) GetDelegation(
+	chain *types.Chain,
+	validator string,
+	delegator string,
+) (*stakingTypes.DelegationResponse, error) {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetRPC(chain)
+	response, err := rpc.GetDelegation(validator, delegator, hosts)
+	return response, err
+}
+
 func (manager *NodeManager) GetRedelegations(chain *types.Chain, address string) (*stakingTypes.QueryRedelegationsResponse, error) {
 	hosts, err := manager.Database.GetLCDHosts(chain)
 	if err != nil {
@@ -243,6 +369,61 @@ func (manager *NodeManager) GetSupply(chain *types.Chain) (*bankTypes.QueryTotal
 	return response, err
 }
 
+func (manager *NodeManager) GetSupplyOf(chain *types.Chain, denom string) (*bankTypes.QuerySupplyOfResponse, error) {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetRPC(chain)
+	response, err := rpc.GetSupplyOf(denom, hosts)
+	return response, err
+}
+
+func (manager *NodeManager) GetDistributionParams(chain *types.Chain) (*distributionTypes.QueryParamsResponse, error) {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetRPC(chain)
+	response, err := rpc.GetDistributionParams(hosts)
+	return response, err
+}
+
+func (manager *NodeManager) GetNodeConfig(chain *types.Chain) (*nodeTypes.ConfigResponse, error) {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetRPC(chain)
+	response, err := rpc.GetNodeConfig(hosts)
+	return response, err
+}
+
+func (manager *NodeManager) GetTxs(chain *types.Chain, events []string, limit int) (*types.TxsResponse, error) {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetRPC(chain)
+	response, err := rpc.GetTxs(hosts, events, limit, 1)
+	return response, err
+}
+
+func (manager *NodeManager) GetAllTxs(chain *types.Chain, events []string, limit int) (*types.TxsResponse, error) {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetRPC(chain)
+	response, err := rpc.GetAllTxs(hosts, events, limit)
+	return response, err
+}
+
 func (manager *NodeManager) GetCommunityPool(chain *types.Chain) (*distributionTypes.QueryCommunityPoolResponse, error) {
 	hosts, err := manager.Database.GetLCDHosts(chain)
 	if err != nil {
@@ -254,6 +435,17 @@ func (manager *NodeManager) GetCommunityPool(chain *types.Chain) (*distributionT
 	return response, err
 }
 
+func (manager *NodeManager) GetLatestBlock(chain *types.Chain) (*cmtservice.GetLatestBlockResponse, error) {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetRPC(chain)
+	response, err := rpc.GetLatestBlock(hosts)
+	return response, err
+}
+
 func (manager *NodeManager) GetBlockTime(chain *types.Chain) (time.Duration, error) {
 	hosts, err := manager.Database.GetLCDHosts(chain)
 	if err != nil {
@@ -276,6 +468,104 @@ func (manager *NodeManager) GetActiveProposals(chain *types.Chain) ([]*types.Pro
 	return response, err
 }
 
+func (manager *NodeManager) GetCurrentUpgradePlan(chain *types.Chain) (*upgradeTypes.QueryCurrentPlanResponse, error) {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetRPC(chain)
+	response, err := rpc.GetCurrentUpgradePlan(hosts)
+	return response, err
+}
+
+func (manager *NodeManager) GetProposals(
+	chain *types.Chain,
+	status govV1Types.ProposalStatus,
+) ([]*types.Proposal, error) {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetRPC(chain)
+	response, err := rpc.GetProposals(hosts, status)
+	return response, err
+}
+
+func (manager *NodeManager) GetPassedUpgradePlans(chain *types.Chain) ([]*types.UpgradePlan, error) {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetRPC(chain)
+	response, err := rpc.GetPassedUpgradePlans(hosts)
+	return response, err
+}
+
+func (manager *NodeManager) GetProposalTally(chain *types.Chain, id string) (*types.ProposalTally, error) {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc := manager.GetRPC(chain)
+	response, err := rpc.GetProposalTally(id, hosts)
+	return response, err
+}
+
+// GetProposalVotes returns votes of the given voters on the proposal, keyed by voter address.
+// Voters who haven't voted have a nil vote, voters whose votes could not be fetched
+// are returned in the errors map.
+func (manager *NodeManager) GetProposalVotes(
+	chain *types.Chain,
+	id string,
+	voters []string,
+) (map[string]*types.ProposalVote, map[string]error, error) {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rpc := manager.GetRPC(chain)
+
+	votes := make(map[string]*types.ProposalVote, len(voters))
+	errs := map[string]error{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	semaphore := make(chan struct{}, constants.ProposalVotesConcurrency)
+
+	for _, voter := range voters {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(voter string) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			vote, voteErr := rpc.GetProposalVote(id, voter, hosts)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if voteErr != nil {
+				errs[voter] = voteErr
+			} else {
+				votes[voter] = vote
+			}
+		}(voter)
+	}
+
+	wg.Wait()
+
+	return votes, errs, nil
+}
+
 func (manager *NodeManager) GetSingleProposal(chain *types.Chain, id string) (*types.Proposal, error) {
 	hosts, err := manager.Database.GetLCDHosts(chain)
 	if err != nil {
@@ -286,3 +576,13 @@ func (manager *NodeManager) GetSingleProposal(chain *types.Chain, id string) (*t
 	response, err := rpc.GetSingleProposal(id, hosts)
 	return response, err
 }
+
+func (manager *NodeManager) GetJSON(chain *types.Chain, url string, queryName string, target interface{}) error {
+	hosts, err := manager.Database.GetLCDHosts(chain)
+	if err != nil {
+		return err
+	}
+
+	rpc := manager.GetRPC(chain)
+	return rpc.GetJSON(hosts, url, queryName, target)
+}
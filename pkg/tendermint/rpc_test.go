@@ -0,0 +1,243 @@
+package tendermint
+
+import (
+	"errors"
+	"main/assets"
+	converterPkg "main/pkg/converter"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:paralleltest // disabled
+func TestRPCDeduplicatesConcurrentQueries(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/params",
+		func(request *http.Request) (*http.Response, error) {
+			time.Sleep(100 * time.Millisecond)
+			return httpmock.NewBytesResponse(200, assets.GetBytesOrPanic("staking-params.json")), nil
+		})
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	converter := converterPkg.NewConverter()
+	rpc := NewRPC(&types.Chain{Name: "chain"}, 10, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, logger, converter, metricsManager)
+
+	var wg sync.WaitGroup
+
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			response, err := rpc.GetStakingParams([]string{"https://example.com"})
+			assert.NoError(t, err)
+			assert.NotNil(t, response)
+			assert.Equal(t, uint32(200), response.Params.MaxValidators)
+		}()
+	}
+
+	wg.Wait()
+
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+//nolint:paralleltest // disabled
+func TestRPCDoesNotDeduplicateSequentialQueries(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/params",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("staking-params.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	converter := converterPkg.NewConverter()
+	rpc := NewRPC(&types.Chain{Name: "chain"}, 10, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, logger, converter, metricsManager)
+
+	for range 2 {
+		_, err := rpc.GetStakingParams([]string{"https://example.com"})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+//nolint:paralleltest // disabled
+func TestRPCFollowsPagination(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/supply?pagination.limit=1",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("supply-page-1.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/supply?pagination.limit=1&pagination.key=dWF0b20%3D",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("supply-page-2.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	converter := converterPkg.NewConverter()
+	rpc := NewRPC(&types.Chain{Name: "chain"}, 10, types.PaginationConfig{PageSize: 1, MaxPages: 100}, logger, converter, metricsManager)
+
+	response, err := rpc.GetSupply([]string{"https://example.com"})
+	require.NoError(t, err)
+	require.Len(t, response.Supply, 2)
+	require.Equal(t, "uatom", response.Supply[0].Denom)
+	require.Equal(t, "uosmo", response.Supply[1].Denom)
+}
+
+//nolint:paralleltest // disabled
+func TestRPCStopsAtMaxPages(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/supply?pagination.limit=1",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("supply-page-1.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	converter := converterPkg.NewConverter()
+	rpc := NewRPC(&types.Chain{Name: "chain"}, 10, types.PaginationConfig{PageSize: 1, MaxPages: 1}, logger, converter, metricsManager)
+
+	response, err := rpc.GetSupply([]string{"https://example.com"})
+	require.NoError(t, err)
+	require.Len(t, response.Supply, 1)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+//nolint:paralleltest // disabled
+func TestRPCGetTxsFallsBackToEvents(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// chains before Cosmos SDK v0.50 do not know about the query param
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/tx/v1beta1/txs?query=message.sender%3D%27cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2%27+AND+tx.height%3E%3D10&order_by=ORDER_BY_DESC&limit=10&page=1",
+		httpmock.NewBytesResponder(400, assets.GetBytesOrPanic("lcd-txs-events-required.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/tx/v1beta1/txs?events=message.sender%3D%27cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2%27&events=tx.height%3E%3D10&order_by=ORDER_BY_DESC&limit=10&page=1",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("txs-sender.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	converter := converterPkg.NewConverter()
+	rpc := NewRPC(&types.Chain{Name: "chain"}, 10, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, logger, converter, metricsManager)
+
+	response, err := rpc.GetTxs(
+		[]string{"https://example.com"},
+		[]string{"message.sender='cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2'", "tx.height>=10"},
+		10,
+		1,
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, response.TxResponses)
+}
+
+//nolint:paralleltest // disabled
+func TestRPCGetTxsNoFallbackOnNetworkError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/tx/v1beta1/txs?query=message.sender%3D%27cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2%27&order_by=ORDER_BY_DESC&limit=10&page=1",
+		httpmock.NewErrorResponder(errors.New("custom error")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/tx/v1beta1/txs?events=message.sender%3D%27cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2%27&order_by=ORDER_BY_DESC&limit=10&page=1",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("txs-sender.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	converter := converterPkg.NewConverter()
+	rpc := NewRPC(&types.Chain{Name: "chain"}, 10, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, logger, converter, metricsManager)
+
+	_, err := rpc.GetTxs(
+		[]string{"https://example.com"},
+		[]string{"message.sender='cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2'"},
+		10,
+		1,
+	)
+	require.Error(t, err)
+	require.Zero(t, httpmock.GetCallCountInfo()["GET https://example.com/cosmos/tx/v1beta1/txs?events=message.sender%3D%27cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2%27&order_by=ORDER_BY_DESC&limit=10&page=1"])
+}
+
+//nolint:paralleltest // disabled
+func TestRPCGetAllTxs(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/tx/v1beta1/txs?query=message.sender%3D%27cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2%27&order_by=ORDER_BY_DESC&limit=3&page=1",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("txs-sender-page-1.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/tx/v1beta1/txs?query=message.sender%3D%27cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2%27&order_by=ORDER_BY_DESC&limit=3&page=2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("txs-sender-page-2.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	converter := converterPkg.NewConverter()
+	rpc := NewRPC(&types.Chain{Name: "chain"}, 10, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, logger, converter, metricsManager)
+
+	response, err := rpc.GetAllTxs(
+		[]string{"https://example.com"},
+		[]string{"message.sender='cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2'"},
+		3,
+	)
+	require.NoError(t, err)
+	require.Len(t, response.TxResponses, 5)
+	require.Equal(t, "FFFF", response.TxResponses[4].TxHash)
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+//nolint:paralleltest // disabled
+func TestRPCGetAllTxsStopsAtMaxPages(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/tx/v1beta1/txs?query=message.sender%3D%27cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2%27&order_by=ORDER_BY_DESC&limit=3&page=1",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("txs-sender-page-1.json")))
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	converter := converterPkg.NewConverter()
+	rpc := NewRPC(&types.Chain{Name: "chain"}, 10, types.PaginationConfig{PageSize: 1000, MaxPages: 1}, logger, converter, metricsManager)
+
+	response, err := rpc.GetAllTxs(
+		[]string{"https://example.com"},
+		[]string{"message.sender='cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2'"},
+		3,
+	)
+	require.NoError(t, err)
+	require.Len(t, response.TxResponses, 3)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
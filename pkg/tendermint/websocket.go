@@ -0,0 +1,320 @@
+package tendermint
+
+import (
+	"encoding/json"
+	"fmt"
+	"main/pkg/constants"
+	databasePkg "main/pkg/database"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+)
+
+type EventHandler func(event types.CometBFTEvent)
+
+type Subscription struct {
+	Query   string
+	Handler EventHandler
+}
+
+type SubscribeRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	Method  string `json:"method"`
+	ID      int    `json:"id"`
+	Params  struct {
+		Query string `json:"query"`
+	} `json:"params"`
+}
+
+// WebsocketManager holds a websocket client per chain, creating it
+// on the first subscription to this chain's events.
+type WebsocketManager struct {
+	Logger         zerolog.Logger
+	Database       *databasePkg.Database
+	MetricsManager *metrics.Manager
+	Clients        map[string]*WebsocketClient
+
+	mutex sync.Mutex
+}
+
+func NewWebsocketManager(
+	logger *zerolog.Logger,
+	database *databasePkg.Database,
+	metricsManager *metrics.Manager,
+) *WebsocketManager {
+	return &WebsocketManager{
+		Logger:         logger.With().Str("component", "websocket_manager").Logger(),
+		Database:       database,
+		MetricsManager: metricsManager,
+		Clients:        map[string]*WebsocketClient{},
+	}
+}
+
+func (m *WebsocketManager) Subscribe(chain *types.Chain, query string, handler EventHandler) {
+	m.mutex.Lock()
+	client, ok := m.Clients[chain.Name]
+	if !ok {
+		client = NewWebsocketClient(chain, &m.Logger, m.Database, m.MetricsManager)
+		m.Clients[chain.Name] = client
+		go client.Start()
+	}
+	m.mutex.Unlock()
+
+	client.Subscribe(query, handler)
+}
+
+func (m *WebsocketManager) Stop() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, client := range m.Clients {
+		client.Stop()
+	}
+}
+
+// WebsocketClient keeps a websocket connection to one of the chain RPC nodes
+// and calls subscriptions handlers on events. If the connection drops,
+// it reconnects to the next node, re-subscribing to everything.
+type WebsocketClient struct {
+	Chain             *types.Chain
+	Logger            zerolog.Logger
+	Database          *databasePkg.Database
+	MetricsManager    *metrics.Manager
+	ReconnectInterval time.Duration
+	ReadTimeout       time.Duration
+	Subscriptions     []*Subscription
+	NodeIndex         int
+
+	conn        *websocket.Conn
+	stopped     bool
+	stopChannel chan bool
+	mutex       sync.Mutex
+	writeMutex  sync.Mutex
+}
+
+func NewWebsocketClient(
+	chain *types.Chain,
+	logger *zerolog.Logger,
+	database *databasePkg.Database,
+	metricsManager *metrics.Manager,
+) *WebsocketClient {
+	return &WebsocketClient{
+		Chain: chain,
+		Logger: logger.With().
+			Str("component", "websocket_client").
+			Str("chain", chain.Name).
+			Logger(),
+		Database:          database,
+		MetricsManager:    metricsManager,
+		ReconnectInterval: constants.WebsocketReconnectInterval,
+		ReadTimeout:       constants.WebsocketReadTimeout,
+		Subscriptions:     []*Subscription{},
+		stopChannel:       make(chan bool),
+	}
+}
+
+func (c *WebsocketClient) Subscribe(query string, handler EventHandler) {
+	c.mutex.Lock()
+	c.Subscriptions = append(c.Subscriptions, &Subscription{Query: query, Handler: handler})
+	id := len(c.Subscriptions) - 1
+	conn := c.conn
+	c.mutex.Unlock()
+
+	// If not connected, it'll subscribe once connected.
+	if conn == nil {
+		return
+	}
+
+	if err := c.SendSubscribe(conn, id, query); err != nil {
+		c.Logger.Warn().Err(err).Str("query", query).Msg("Error subscribing to websocket events")
+	}
+}
+
+func (c *WebsocketClient) Start() {
+	for {
+		err := c.Listen()
+
+		c.mutex.Lock()
+		stopped := c.stopped
+		c.mutex.Unlock()
+
+		if stopped {
+			return
+		}
+
+		c.MetricsManager.LogWebsocketConnected(c.Chain.Name, false)
+		c.Logger.Warn().
+			Err(err).
+			Dur("reconnect_interval", c.ReconnectInterval).
+			Msg("Websocket disconnected, reconnecting")
+
+		select {
+		case <-c.stopChannel:
+			return
+		case <-time.After(c.ReconnectInterval):
+		}
+	}
+}
+
+func (c *WebsocketClient) Stop() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.stopped {
+		return
+	}
+
+	c.stopped = true
+	close(c.stopChannel)
+
+	if c.conn != nil {
+		_ = c.conn.Close()
+	}
+}
+
+func (c *WebsocketClient) Listen() error {
+	hosts, err := c.Database.GetRPCNodes(c.Chain)
+	if err != nil {
+		return err
+	}
+
+	if len(hosts) == 0 {
+		return constants.ErrNoRPCNodes
+	}
+
+	// Rotating nodes, so a node that's down won't be retried forever.
+	host := hosts[c.NodeIndex%len(hosts)]
+	c.NodeIndex++
+
+	websocketURL, err := GetWebsocketURL(host)
+	if err != nil {
+		return err
+	}
+
+	conn, _, err := websocket.DefaultDialer.Dial(websocketURL, nil)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	c.mutex.Lock()
+	if c.stopped {
+		c.mutex.Unlock()
+		return nil
+	}
+
+	c.conn = conn
+	subscriptions := make([]*Subscription, len(c.Subscriptions))
+	copy(subscriptions, c.Subscriptions)
+	c.mutex.Unlock()
+
+	defer func() {
+		c.mutex.Lock()
+		c.conn = nil
+		c.mutex.Unlock()
+	}()
+
+	for index, subscription := range subscriptions {
+		if err := c.SendSubscribe(conn, index, subscription.Query); err != nil {
+			return err
+		}
+	}
+
+	c.Logger.Info().Str("host", host).Msg("Connected to websocket")
+	c.MetricsManager.LogWebsocketConnected(c.Chain.Name, true)
+
+	_ = conn.SetReadDeadline(time.Now().Add(c.ReadTimeout))
+	conn.SetPingHandler(func(data string) error {
+		_ = conn.SetReadDeadline(time.Now().Add(c.ReadTimeout))
+
+		c.writeMutex.Lock()
+		defer c.writeMutex.Unlock()
+		return conn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(time.Second))
+	})
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+
+		_ = conn.SetReadDeadline(time.Now().Add(c.ReadTimeout))
+		c.HandleMessage(message)
+	}
+}
+
+func (c *WebsocketClient) SendSubscribe(conn *websocket.Conn, id int, query string) error {
+	request := SubscribeRequest{JSONRPC: "2.0", Method: "subscribe", ID: id}
+	request.Params.Query = query
+
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+
+	return conn.WriteJSON(request)
+}
+
+func (c *WebsocketClient) HandleMessage(message []byte) {
+	var response types.CometBFTResponse
+	if err := json.Unmarshal(message, &response); err != nil {
+		c.Logger.Warn().Err(err).Msg("Error unmarshalling websocket message")
+		return
+	}
+
+	if response.Error != nil {
+		c.Logger.Warn().Err(response.Error).Msg("Got error from websocket")
+		return
+	}
+
+	var event types.CometBFTEvent
+	if err := json.Unmarshal(response.Result, &event); err != nil {
+		c.Logger.Warn().Err(err).Msg("Error unmarshalling websocket event")
+		return
+	}
+
+	// Subscription confirmations have an empty result.
+	if event.IsEmpty() {
+		c.Logger.Trace().Msg("Got empty websocket event, skipping")
+		return
+	}
+
+	c.mutex.Lock()
+	handlers := make([]EventHandler, 0)
+	for _, subscription := range c.Subscriptions {
+		if subscription.Query == event.Query {
+			handlers = append(handlers, subscription.Handler)
+		}
+	}
+	c.mutex.Unlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
+
+// GetWebsocketURL converts an RPC node URL, like https://rpc.example.com,
+// to its websocket endpoint URL, like wss://rpc.example.com/websocket.
+func GetWebsocketURL(host string) (string, error) {
+	parsed, err := url.Parse(host)
+	if err != nil {
+		return "", err
+	}
+
+	switch parsed.Scheme {
+	case "http":
+		parsed.Scheme = "ws"
+	case "https":
+		parsed.Scheme = "wss"
+	case "ws", "wss":
+	default:
+		return "", fmt.Errorf("unsupported RPC node scheme: %s", parsed.Scheme)
+	}
+
+	parsed.Path = strings.TrimSuffix(parsed.Path, "/") + "/websocket"
+	return parsed.String(), nil
+}
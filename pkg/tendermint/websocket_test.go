@@ -0,0 +1,121 @@
+package tendermint
+
+import (
+	"main/pkg/constants"
+	databasePkg "main/pkg/database"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+const newBlockEvent = `{"jsonrpc":"2.0","id":0,"result":{"query":"tm.event='NewBlock'","data":{"type":"tendermint/event/NewBlock","value":{}},"events":{"tm.event":["NewBlock"],"block.height":["100"]}}}`
+
+func TestGetWebsocketURL(t *testing.T) {
+	t.Parallel()
+
+	websocketURL, err := GetWebsocketURL("https://rpc.example.com")
+	require.NoError(t, err)
+	require.Equal(t, "wss://rpc.example.com/websocket", websocketURL)
+
+	websocketURL, err = GetWebsocketURL("http://localhost:26657/")
+	require.NoError(t, err)
+	require.Equal(t, "ws://localhost:26657/websocket", websocketURL)
+
+	_, err = GetWebsocketURL("ftp://rpc.example.com")
+	require.Error(t, err)
+}
+
+//nolint:paralleltest // disabled
+func TestWebsocketSubscribeAndReconnect(t *testing.T) {
+	var connections atomic.Int32
+
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var request SubscribeRequest
+		if err := conn.ReadJSON(&request); err != nil {
+			return
+		}
+
+		if request.Method != "subscribe" || request.Params.Query != "tm.event='NewBlock'" {
+			return
+		}
+
+		// Subscription confirmation, then the event.
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":0,"result":{}}`))
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(newBlockEvent))
+
+		// The first connection drops right after the event, the second stays open.
+		if connections.Add(1) > 1 {
+			_, _, _ = conn.ReadMessage()
+		}
+	}))
+	defer server.Close()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	database.SetClient(db)
+
+	for range 2 {
+		mock.ExpectQuery("SELECT host FROM rpc_nodes").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow(server.URL))
+	}
+
+	client := NewWebsocketClient(&types.Chain{Name: "chain"}, logger, database, metricsManager)
+	client.ReconnectInterval = 10 * time.Millisecond
+
+	events := make(chan types.CometBFTEvent, 2)
+	client.Subscribe("tm.event='NewBlock'", func(event types.CometBFTEvent) {
+		events <- event
+	})
+
+	go client.Start()
+	defer client.Stop()
+
+	for range 2 {
+		select {
+		case event := <-events:
+			require.Equal(t, "tendermint/event/NewBlock", event.Data.Type)
+			require.Equal(t, []string{"100"}, event.Events["block.height"])
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "Timed out waiting for websocket event")
+		}
+	}
+
+	require.Equal(t, int32(2), connections.Load())
+}
+
+//nolint:paralleltest // disabled
+func TestWebsocketNoRPCNodes(t *testing.T) {
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	database.SetClient(db)
+
+	mock.ExpectQuery("SELECT host FROM rpc_nodes").
+		WillReturnRows(sqlmock.NewRows([]string{"host"}))
+
+	client := NewWebsocketClient(&types.Chain{Name: "chain"}, logger, database, metricsManager)
+	require.ErrorIs(t, client.Listen(), constants.ErrNoRPCNodes)
+}
@@ -5,11 +5,16 @@ import (
 )
 
 type Time interface {
+	Now() time.Time
 	Since(sinceTime time.Time) time.Duration
 }
 
 type SystemTime struct{}
 
+func (t *SystemTime) Now() time.Time {
+	return time.Now()
+}
+
 func (t *SystemTime) Since(sinceTime time.Time) time.Duration {
 	return time.Since(sinceTime)
 }
@@ -18,6 +23,10 @@ type StubTime struct {
 	NowTime time.Time
 }
 
+func (t *StubTime) Now() time.Time {
+	return t.NowTime
+}
+
 func (t *StubTime) Since(sinceTime time.Time) time.Duration {
 	return t.NowTime.Sub(sinceTime)
 }
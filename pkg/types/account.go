@@ -0,0 +1,218 @@
+package types
+
+import (
+	"main/pkg/utils"
+	"strings"
+	"time"
+
+	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingExported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingTypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type AccountType string
+
+const (
+	AccountTypeBase              AccountType = "base"
+	AccountTypeContinuousVesting AccountType = "continuous_vesting"
+	AccountTypeDelayedVesting    AccountType = "delayed_vesting"
+	AccountTypePeriodicVesting   AccountType = "periodic_vesting"
+	AccountTypePermanentLocked   AccountType = "permanent_locked"
+	AccountTypeModule            AccountType = "module"
+	AccountTypeUnknown           AccountType = "unknown"
+)
+
+// VestingPeriod is a part of a periodic vesting account schedule,
+// its amounts are unlocked at once when it ends.
+type VestingPeriod struct {
+	EndTime time.Time
+	Amounts []*Amount
+	Vested  bool
+}
+
+type AccountInfo struct {
+	Chain   *Chain
+	Address string
+	Error   error
+
+	Type          AccountType
+	AccountNumber uint64
+	Sequence      uint64
+	// Accounts of unknown types only, as their other fields cannot be decoded.
+	TypeURL string
+	// Module accounts only.
+	ModuleName  string
+	Permissions []string
+
+	// Vesting accounts only. The start time is not set for delayed vesting accounts,
+	// and neither of the times is set for permanently locked accounts.
+	VestingStartTime time.Time
+	VestingEndTime   time.Time
+	OriginalVesting  []*Amount
+	Vested           []*Amount
+	Vesting          []*Amount
+	DelegatedVesting []*Amount
+	Periods          []*VestingPeriod
+
+	// Balances are the total ones, locked are the ones that cannot be sent yet,
+	// as they are still vesting and are not delegated.
+	Balances  []*Amount
+	Spendable []*Amount
+	Locked    []*Amount
+}
+
+func NewAccountInfo(
+	chain *Chain,
+	address string,
+	account cosmosTypes.AccountI,
+	balances cosmosTypes.Coins,
+	spendable cosmosTypes.Coins,
+	now time.Time,
+) AccountInfo {
+	info := AccountInfo{
+		Chain:     chain,
+		Address:   address,
+		Type:      GetAccountType(account),
+		Balances:  utils.Map(balances, AmountFrom),
+		Spendable: utils.Map(spendable, AmountFrom),
+	}
+
+	// the spendable balance of a vesting account with delegations can be more than its balance
+	if locked, hasNegative := balances.SafeSub(spendable...); !hasNegative {
+		info.Locked = utils.Map(locked, AmountFrom)
+	}
+
+	// the account type is unknown, so only the balances are known
+	if account == nil {
+		return info
+	}
+
+	info.AccountNumber = account.GetAccountNumber()
+	info.Sequence = account.GetSequence()
+
+	if moduleAccount, ok := account.(*authTypes.ModuleAccount); ok {
+		info.ModuleName = moduleAccount.Name
+		info.Permissions = moduleAccount.Permissions
+	}
+
+	vestingAccount, ok := account.(vestingExported.VestingAccount)
+	if !ok {
+		return info
+	}
+
+	if startTime := vestingAccount.GetStartTime(); startTime > 0 {
+		info.VestingStartTime = time.Unix(startTime, 0).UTC()
+	}
+
+	if endTime := vestingAccount.GetEndTime(); endTime > 0 {
+		info.VestingEndTime = time.Unix(endTime, 0).UTC()
+	}
+
+	info.OriginalVesting = utils.Map(vestingAccount.GetOriginalVesting(), AmountFrom)
+	info.Vested = utils.Map(vestingAccount.GetVestedCoins(now), AmountFrom)
+	info.Vesting = utils.Map(vestingAccount.GetVestingCoins(now), AmountFrom)
+	info.DelegatedVesting = utils.Map(vestingAccount.GetDelegatedVesting(), AmountFrom)
+
+	if periodicAccount, ok := account.(*vestingTypes.PeriodicVestingAccount); ok {
+		endTime := info.VestingStartTime
+
+		for _, period := range periodicAccount.VestingPeriods {
+			endTime = endTime.Add(time.Duration(period.Length) * time.Second)
+
+			info.Periods = append(info.Periods, &VestingPeriod{
+				EndTime: endTime,
+				Amounts: utils.Map(period.Amount, AmountFrom),
+				Vested:  !endTime.After(now),
+			})
+		}
+	}
+
+	return info
+}
+
+func GetAccountType(account cosmosTypes.AccountI) AccountType {
+	switch account.(type) {
+	case *authTypes.BaseAccount:
+		return AccountTypeBase
+	case *authTypes.ModuleAccount:
+		return AccountTypeModule
+	case *vestingTypes.ContinuousVestingAccount:
+		return AccountTypeContinuousVesting
+	case *vestingTypes.DelayedVestingAccount:
+		return AccountTypeDelayedVesting
+	case *vestingTypes.PeriodicVestingAccount:
+		return AccountTypePeriodicVesting
+	case *vestingTypes.PermanentLockedAccount:
+		return AccountTypePermanentLocked
+	default:
+		return AccountTypeUnknown
+	}
+}
+
+func (a AccountInfo) GetTypeName() string {
+	switch a.Type {
+	case AccountTypeBase:
+		return "Regular account"
+	case AccountTypeModule:
+		return "Module account"
+	case AccountTypeContinuousVesting:
+		return "Continuous vesting account"
+	case AccountTypeDelayedVesting:
+		return "Delayed vesting account"
+	case AccountTypePeriodicVesting:
+		return "Periodic vesting account"
+	case AccountTypePermanentLocked:
+		return "Permanently locked account"
+	default:
+		return "Unknown account type"
+	}
+}
+
+func (a AccountInfo) IsKnownType() bool {
+	return a.Type != AccountTypeUnknown
+}
+
+func (a AccountInfo) IsVesting() bool {
+	switch a.Type {
+	case AccountTypeContinuousVesting,
+		AccountTypeDelayedVesting,
+		AccountTypePeriodicVesting,
+		AccountTypePermanentLocked:
+		return true
+	default:
+		return false
+	}
+}
+
+func (a AccountInfo) FormatPermissions() string {
+	return strings.Join(a.Permissions, ", ")
+}
+
+// GetAmounts returns all the account amounts, so they can be converted
+// to the display denom at once.
+func (a AccountInfo) GetAmounts() []*AmountWithChain {
+	lists := [][]*Amount{
+		a.OriginalVesting,
+		a.Vested,
+		a.Vesting,
+		a.DelegatedVesting,
+		a.Balances,
+		a.Spendable,
+		a.Locked,
+	}
+
+	for _, period := range a.Periods {
+		lists = append(lists, period.Amounts)
+	}
+
+	amounts := make([]*AmountWithChain, 0)
+
+	for _, list := range lists {
+		for _, amount := range list {
+			amounts = append(amounts, &AmountWithChain{Chain: a.Chain.Name, Amount: amount})
+		}
+	}
+
+	return amounts
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingTypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func getAccountCoins(amount int64) cosmosTypes.Coins {
+	return cosmosTypes.NewCoins(cosmosTypes.NewCoin("uatom", math.NewInt(amount)))
+}
+
+func TestGetAccountTypeUnknown(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, AccountTypeUnknown, GetAccountType(nil))
+	require.Equal(t, "Unknown account type", AccountInfo{Type: AccountTypeUnknown}.GetTypeName())
+}
+
+func TestNewAccountInfoUnknown(t *testing.T) {
+	t.Parallel()
+
+	info := NewAccountInfo(&Chain{Name: "chain"}, "address", nil, getAccountCoins(100), getAccountCoins(100), time.Now())
+
+	require.Equal(t, AccountTypeUnknown, info.Type)
+	require.False(t, info.IsKnownType())
+	require.False(t, info.IsVesting())
+	require.Zero(t, info.AccountNumber)
+	require.Empty(t, info.Locked)
+	require.Len(t, info.GetAmounts(), 2)
+}
+
+func TestNewAccountInfoBase(t *testing.T) {
+	t.Parallel()
+
+	account := authTypes.NewBaseAccount(cosmosTypes.AccAddress("address"), nil, 1, 2)
+	info := NewAccountInfo(&Chain{Name: "chain"}, "address", account, getAccountCoins(100), getAccountCoins(100), time.Now())
+
+	require.Equal(t, AccountTypeBase, info.Type)
+	require.Equal(t, "Regular account", info.GetTypeName())
+	require.False(t, info.IsVesting())
+	require.Equal(t, uint64(1), info.AccountNumber)
+	require.Equal(t, uint64(2), info.Sequence)
+	require.Empty(t, info.Locked)
+	require.Len(t, info.GetAmounts(), 2)
+}
+
+func TestNewAccountInfoModule(t *testing.T) {
+	t.Parallel()
+
+	account := authTypes.NewEmptyModuleAccount("distribution", "minter", "burner")
+	info := NewAccountInfo(&Chain{Name: "chain"}, "address", account, nil, nil, time.Now())
+
+	require.Equal(t, AccountTypeModule, info.Type)
+	require.Equal(t, "distribution", info.ModuleName)
+	require.Equal(t, "minter, burner", info.FormatPermissions())
+	require.Empty(t, info.GetAmounts())
+}
+
+func TestNewAccountInfoDelayedVesting(t *testing.T) {
+	t.Parallel()
+
+	endTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	account, err := vestingTypes.NewDelayedVestingAccount(
+		authTypes.NewBaseAccountWithAddress(cosmosTypes.AccAddress("address")),
+		getAccountCoins(100),
+		endTime.Unix(),
+	)
+	require.NoError(t, err)
+
+	info := NewAccountInfo(&Chain{Name: "chain"}, "address", account, getAccountCoins(100), nil, endTime.Add(-time.Hour))
+
+	require.Equal(t, AccountTypeDelayedVesting, info.Type)
+	require.True(t, info.IsVesting())
+	require.True(t, info.VestingStartTime.IsZero())
+	require.Equal(t, endTime, info.VestingEndTime)
+	require.Empty(t, info.Vested)
+	require.Len(t, info.Vesting, 1)
+	require.Equal(t, math.LegacyNewDec(100), info.Vesting[0].Amount)
+	require.Len(t, info.Locked, 1)
+	require.Equal(t, math.LegacyNewDec(100), info.Locked[0].Amount)
+}
+
+func TestNewAccountInfoPermanentLocked(t *testing.T) {
+	t.Parallel()
+
+	account, err := vestingTypes.NewPermanentLockedAccount(
+		authTypes.NewBaseAccountWithAddress(cosmosTypes.AccAddress("address")),
+		getAccountCoins(100),
+	)
+	require.NoError(t, err)
+
+	info := NewAccountInfo(&Chain{Name: "chain"}, "address", account, getAccountCoins(100), nil, time.Now())
+
+	require.Equal(t, AccountTypePermanentLocked, info.Type)
+	require.True(t, info.VestingStartTime.IsZero())
+	require.True(t, info.VestingEndTime.IsZero())
+	require.Len(t, info.Vesting, 1)
+}
+
+func TestNewAccountInfoPeriodicVesting(t *testing.T) {
+	t.Parallel()
+
+	startTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	account, err := vestingTypes.NewPeriodicVestingAccount(
+		authTypes.NewBaseAccountWithAddress(cosmosTypes.AccAddress("address")),
+		getAccountCoins(200),
+		startTime.Unix(),
+		vestingTypes.Periods{
+			{Length: 3600, Amount: getAccountCoins(100)},
+			{Length: 3600, Amount: getAccountCoins(100)},
+		},
+	)
+	require.NoError(t, err)
+
+	info := NewAccountInfo(
+		&Chain{Name: "chain"},
+		"address",
+		account,
+		getAccountCoins(200),
+		getAccountCoins(100),
+		startTime.Add(90*time.Minute),
+	)
+
+	require.Equal(t, AccountTypePeriodicVesting, info.Type)
+	require.Len(t, info.Periods, 2)
+	require.Equal(t, startTime.Add(time.Hour), info.Periods[0].EndTime)
+	require.True(t, info.Periods[0].Vested)
+	require.Equal(t, startTime.Add(2*time.Hour), info.Periods[1].EndTime)
+	require.False(t, info.Periods[1].Vested)
+	require.Len(t, info.Locked, 1)
+	require.Equal(t, math.LegacyNewDec(100), info.Locked[0].Amount)
+}
+
+func TestNewAccountInfoSpendableMoreThanBalance(t *testing.T) {
+	t.Parallel()
+
+	account := authTypes.NewBaseAccountWithAddress(cosmosTypes.AccAddress("address"))
+	info := NewAccountInfo(&Chain{Name: "chain"}, "address", account, getAccountCoins(100), getAccountCoins(200), time.Now())
+
+	require.Empty(t, info.Locked)
+}
@@ -0,0 +1,220 @@
+package types
+
+import (
+	"errors"
+	"slices"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/guregu/null/v5"
+
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type ActiveSetConfig struct {
+	Enabled  null.Bool     `default:"true" toml:"enabled"`
+	Interval time.Duration `default:"10m"  toml:"interval"`
+	// Users having a validator linked are notified once its margin,
+	// in percents of its tokens, drops below this.
+	MarginThreshold float64 `default:"5" toml:"margin-threshold"`
+}
+
+func (c *ActiveSetConfig) Validate() error {
+	if c.Interval <= 0 {
+		return errors.New("interval should be positive")
+	}
+
+	if c.MarginThreshold <= 0 || c.MarginThreshold >= 100 {
+		return errors.New("margin threshold should be between 0 and 100")
+	}
+
+	return nil
+}
+
+// ActiveSetValidator is a validator with its position relative to the active set edge.
+type ActiveSetValidator struct {
+	OperatorAddress string
+	Moniker         string
+	// Position by tokens among the validators that are not jailed, starting from 1,
+	// or 0 for jailed validators.
+	Rank   int
+	Active bool
+	Jailed bool
+	Tokens *Amount
+	// For active validators, how many tokens they have more than the first validator
+	// outside the active set, for inactive ones, how many they lack to get into it.
+	// It is not set if the active set is not full, as nobody can be pushed out of it.
+	Margin *Amount
+	// Margin relative to the validator tokens, from 0 to 1.
+	MarginPercent float64
+}
+
+func (v *ActiveSetValidator) HasMargin() bool {
+	return v.Margin != nil
+}
+
+// ActiveSet is the validators of a chain ordered by tokens, the top ones up to
+// the max validators staking param being the active set.
+type ActiveSet struct {
+	Chain         *Chain
+	MaxValidators uint32
+	// Tokens of the last active validator, a validator needs more than that
+	// to get into the active set. It is not set if the active set is not full.
+	MinStake   *Amount
+	Validators []*ActiveSetValidator
+	// Validators at the bottom of the active set, the ones closest to being pushed out of it.
+	LastValidators []*ActiveSetValidator
+	// Validators linked by the user requesting the active set.
+	LinkedValidators []*ActiveSetValidator
+}
+
+func NewActiveSet(chain *Chain, validators []stakingTypes.Validator, maxValidators uint32) *ActiveSet {
+	sorted := make([]stakingTypes.Validator, len(validators))
+	copy(sorted, validators)
+
+	// Jailed validators cannot get into the active set, so they go last.
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Jailed != sorted[j].Jailed {
+			return !sorted[i].Jailed
+		}
+
+		return sorted[i].Tokens.GT(sorted[j].Tokens)
+	})
+
+	activeSet := &ActiveSet{
+		Chain:         chain,
+		MaxValidators: maxValidators,
+		Validators:    make([]*ActiveSetValidator, len(sorted)),
+	}
+
+	candidates := 0
+	for _, validator := range sorted {
+		if !validator.Jailed {
+			candidates++
+		}
+	}
+
+	isFull := candidates > int(maxValidators) && maxValidators > 0
+
+	var lastActiveTokens, firstInactiveTokens math.Int
+	if isFull {
+		lastActiveTokens = sorted[maxValidators-1].Tokens
+		firstInactiveTokens = sorted[maxValidators].Tokens
+		activeSet.MinStake = activeSet.NewAmount(lastActiveTokens)
+	}
+
+	for index, validator := range sorted {
+		activeSetValidator := &ActiveSetValidator{
+			OperatorAddress: validator.OperatorAddress,
+			Moniker:         validator.Description.Moniker,
+			Jailed:          validator.Jailed,
+			Active:          !validator.Jailed && index < int(maxValidators),
+			Tokens:          activeSet.NewAmount(validator.Tokens),
+		}
+
+		if !validator.Jailed {
+			activeSetValidator.Rank = index + 1
+		}
+
+		if isFull && !validator.Jailed {
+			margin := lastActiveTokens.Sub(validator.Tokens)
+			if activeSetValidator.Active {
+				margin = validator.Tokens.Sub(firstInactiveTokens)
+			}
+
+			activeSetValidator.Margin = activeSet.NewAmount(margin)
+
+			if !validator.Tokens.IsZero() {
+				activeSetValidator.MarginPercent = margin.ToLegacyDec().
+					Quo(validator.Tokens.ToLegacyDec()).
+					MustFloat64()
+			}
+		}
+
+		activeSet.Validators[index] = activeSetValidator
+	}
+
+	return activeSet
+}
+
+func (s *ActiveSet) NewAmount(tokens math.Int) *Amount {
+	return &Amount{Amount: tokens.ToLegacyDec(), Denom: s.Chain.BaseDenom}
+}
+
+func (s *ActiveSet) IsFull() bool {
+	return s.MinStake != nil
+}
+
+func (s *ActiveSet) GetActiveValidatorsCount() int {
+	count := 0
+	for _, validator := range s.Validators {
+		if validator.Active {
+			count++
+		}
+	}
+
+	return count
+}
+
+// SetLastValidators sets up to count validators at the bottom of the active set
+// as the last ones.
+func (s *ActiveSet) SetLastValidators(count int) {
+	active := s.GetActiveValidatorsCount()
+	s.LastValidators = s.Validators[max(active-count, 0):active]
+}
+
+func (s *ActiveSet) GetValidator(address string) (*ActiveSetValidator, bool) {
+	for _, validator := range s.Validators {
+		if validator.OperatorAddress == address {
+			return validator, true
+		}
+	}
+
+	return nil, false
+}
+
+// SetLinkedValidators sets the validators linked by a user,
+// skipping the ones that are not found.
+func (s *ActiveSet) SetLinkedValidators(addresses []string) {
+	s.LinkedValidators = make([]*ActiveSetValidator, 0, len(addresses))
+
+	for _, address := range addresses {
+		if validator, found := s.GetValidator(address); found {
+			s.LinkedValidators = append(s.LinkedValidators, validator)
+		}
+	}
+}
+
+// GetDisplayedAmounts returns the amounts shown to the users, being the min stake
+// and the ones of the last and the linked validators, so only they are converted
+// to the display denom. Each amount is returned once, as converting it changes it in place.
+func (s *ActiveSet) GetDisplayedAmounts() []*AmountWithChain {
+	amounts := make([]*AmountWithChain, 0)
+	seen := map[*Amount]bool{}
+
+	add := func(amount *Amount) {
+		if amount != nil && !seen[amount] {
+			seen[amount] = true
+			amounts = append(amounts, &AmountWithChain{Chain: s.Chain.Name, Amount: amount})
+		}
+	}
+
+	add(s.MinStake)
+
+	for _, validator := range slices.Concat(s.LastValidators, s.LinkedValidators) {
+		add(validator.Tokens)
+		add(validator.Margin)
+	}
+
+	return amounts
+}
+
+// ActiveSetWarning is sent to the users having a validator linked
+// once its margin drops below the configured threshold.
+type ActiveSetWarning struct {
+	Chain         *Chain
+	Explorers     Explorers
+	MaxValidators uint32
+	Validator     *ActiveSetValidator
+}
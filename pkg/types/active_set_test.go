@@ -0,0 +1,116 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func getActiveSetValidator(address string, tokens int64, jailed bool) stakingTypes.Validator {
+	return stakingTypes.Validator{
+		OperatorAddress: address,
+		Description:     stakingTypes.Description{Moniker: address},
+		Tokens:          math.NewInt(tokens),
+		Jailed:          jailed,
+	}
+}
+
+func TestValidateActiveSetConfigInvalidInterval(t *testing.T) {
+	t.Parallel()
+
+	config := &ActiveSetConfig{MarginThreshold: 5}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateActiveSetConfigInvalidThreshold(t *testing.T) {
+	t.Parallel()
+
+	config := &ActiveSetConfig{Interval: time.Minute, MarginThreshold: 100}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateActiveSetConfigOk(t *testing.T) {
+	t.Parallel()
+
+	config := &ActiveSetConfig{Interval: time.Minute, MarginThreshold: 5}
+	err := config.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
+
+func TestActiveSetNotFull(t *testing.T) {
+	t.Parallel()
+
+	activeSet := NewActiveSet(&Chain{Name: "chain", BaseDenom: "uatom"}, []stakingTypes.Validator{
+		getActiveSetValidator("first", 100, false),
+		getActiveSetValidator("second", 200, false),
+		getActiveSetValidator("jailed", 300, true),
+	}, 3)
+
+	require.False(t, activeSet.IsFull())
+	require.Equal(t, 2, activeSet.GetActiveValidatorsCount())
+
+	second, found := activeSet.GetValidator("second")
+	require.True(t, found)
+	require.Equal(t, 1, second.Rank)
+	require.True(t, second.Active)
+	require.False(t, second.HasMargin())
+
+	jailed, found := activeSet.GetValidator("jailed")
+	require.True(t, found)
+	require.Zero(t, jailed.Rank)
+	require.False(t, jailed.Active)
+	require.True(t, jailed.Jailed)
+
+	_, found = activeSet.GetValidator("missing")
+	require.False(t, found)
+}
+
+func TestActiveSetFull(t *testing.T) {
+	t.Parallel()
+
+	activeSet := NewActiveSet(&Chain{Name: "chain", BaseDenom: "uatom"}, []stakingTypes.Validator{
+		getActiveSetValidator("inactive", 90, false),
+		getActiveSetValidator("jailed", 1000, true),
+		getActiveSetValidator("first", 200, false),
+		getActiveSetValidator("last", 100, false),
+	}, 2)
+
+	require.True(t, activeSet.IsFull())
+	require.Equal(t, "100.000000000000000000", activeSet.MinStake.Amount.String())
+	require.Equal(t, 2, activeSet.GetActiveValidatorsCount())
+
+	last, found := activeSet.GetValidator("last")
+	require.True(t, found)
+	require.Equal(t, 2, last.Rank)
+	require.True(t, last.Active)
+	require.Equal(t, "10.000000000000000000", last.Margin.Amount.String())
+	require.InDelta(t, 0.1, last.MarginPercent, 0.0001)
+
+	inactive, found := activeSet.GetValidator("inactive")
+	require.True(t, found)
+	require.Equal(t, 3, inactive.Rank)
+	require.False(t, inactive.Active)
+	require.Equal(t, "10.000000000000000000", inactive.Margin.Amount.String())
+
+	jailed, found := activeSet.GetValidator("jailed")
+	require.True(t, found)
+	require.False(t, jailed.Active)
+	require.False(t, jailed.HasMargin())
+
+	activeSet.SetLastValidators(1)
+	require.Len(t, activeSet.LastValidators, 1)
+	require.Equal(t, "last", activeSet.LastValidators[0].OperatorAddress)
+
+	activeSet.SetLinkedValidators([]string{"last", "missing", "inactive"})
+	require.Len(t, activeSet.LinkedValidators, 2)
+
+	// min stake, and tokens and margins of "last" and "inactive", with "last" counted once
+	require.Len(t, activeSet.GetDisplayedAmounts(), 5)
+	require.Len(t, activeSet.Validators, 4)
+}
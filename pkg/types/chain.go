@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"main/pkg/constants"
+	"strconv"
+	"strings"
 )
 
 type Chain struct {
@@ -9,6 +12,12 @@ type Chain struct {
 	PrettyName            string `toml:"pretty-name"`
 	BaseDenom             string `toml:"base-denom"`
 	Bech32ValidatorPrefix string
+	Bech32AccountPrefix   string
+	// SLIP-44 coin type of the chain keys, chains sharing it have the same addresses
+	// with different prefixes.
+	CoinType uint32
+	// Defines how the staking APR is calculated, as some chains have their own mint modules.
+	MintModule constants.MintModule
 }
 
 type ChainWithLCD struct {
@@ -16,16 +25,25 @@ type ChainWithLCD struct {
 	LCDEndpoint string
 }
 
+type ChainWithRPCNode struct {
+	Chain   Chain
+	RPCNode string
+}
+
 type ChainInfo struct {
 	Chain        *Chain
 	Denoms       Denoms
 	Explorers    Explorers
 	LCDEndpoints []string
+	RPCNodes     []string
 }
 
-func ChainFromArgs(args map[string]string) *ChainWithLCD {
+func ChainFromArgs(args map[string]string) (*ChainWithLCD, error) {
 	chain := &ChainWithLCD{
-		Chain: Chain{},
+		Chain: Chain{
+			CoinType:   constants.CosmosCoinType,
+			MintModule: constants.MintModuleStandard,
+		},
 	}
 
 	for key, value := range args {
@@ -34,19 +52,24 @@ func ChainFromArgs(args map[string]string) *ChainWithLCD {
 			chain.Chain.Name = value
 		case "lcd-endpoint":
 			chain.LCDEndpoint = value
-		case "pretty-name":
-			chain.Chain.PrettyName = value
-		case "base-denom":
-			chain.Chain.BaseDenom = value
-		case "bech32-validator-prefix":
-			chain.Chain.Bech32ValidatorPrefix = value
 		}
 	}
 
-	return chain
+	if err := chain.Chain.UpdateFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	// Most chains validator prefix is the accounts one with the valoper suffix.
+	if chain.Chain.Bech32AccountPrefix == "" {
+		if prefix, found := strings.CutSuffix(chain.Chain.Bech32ValidatorPrefix, "valoper"); found {
+			chain.Chain.Bech32AccountPrefix = prefix
+		}
+	}
+
+	return chain, nil
 }
 
-func (c *Chain) UpdateFromArgs(args map[string]string) {
+func (c *Chain) UpdateFromArgs(args map[string]string) error {
 	for key, value := range args {
 		switch key {
 		case "pretty-name":
@@ -55,8 +78,32 @@ func (c *Chain) UpdateFromArgs(args map[string]string) {
 			c.BaseDenom = value
 		case "bech32-validator-prefix":
 			c.Bech32ValidatorPrefix = value
+		case "bech32-account-prefix":
+			c.Bech32AccountPrefix = value
+		case "coin-type":
+			coinType, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid coin type: %s", value)
+			}
+
+			c.CoinType = uint32(coinType)
+		case "mint-module":
+			switch mintModule := constants.MintModule(value); mintModule {
+			case constants.MintModuleStandard, constants.MintModuleCelestia, constants.MintModuleOsmosis:
+				c.MintModule = mintModule
+			default:
+				return fmt.Errorf(
+					"invalid mint module: %s, expected one of: %s, %s, %s",
+					value,
+					constants.MintModuleStandard,
+					constants.MintModuleCelestia,
+					constants.MintModuleOsmosis,
+				)
+			}
 		}
 	}
+
+	return nil
 }
 
 func (c *ChainWithLCD) Validate() error {
@@ -84,6 +131,10 @@ func (c *Chain) Validate() error {
 		return fmt.Errorf("empty bech32 validator prefix")
 	}
 
+	if c.Bech32AccountPrefix == "" {
+		return fmt.Errorf("empty bech32 account prefix")
+	}
+
 	return nil
 }
 
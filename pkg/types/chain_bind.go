@@ -0,0 +1,8 @@
+package types
+
+type ChainBind struct {
+	Chain    string
+	Reporter string
+	ChatID   string
+	ChatName string
+}
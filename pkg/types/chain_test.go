@@ -0,0 +1,57 @@
+package types
+
+import (
+	"main/pkg/constants"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainFromArgsDefaults(t *testing.T) {
+	t.Parallel()
+
+	chain, err := ChainFromArgs(map[string]string{
+		"name":                    "cosmos",
+		"lcd-endpoint":            "https://example.com",
+		"base-denom":              "uatom",
+		"bech32-validator-prefix": "cosmosvaloper",
+	})
+	require.NoError(t, err)
+	require.NoError(t, chain.Validate())
+	require.Equal(t, "cosmos", chain.Chain.Bech32AccountPrefix)
+	require.Equal(t, uint32(118), chain.Chain.CoinType)
+	require.Equal(t, constants.MintModuleStandard, chain.Chain.MintModule)
+}
+
+func TestChainFromArgsNoAccountPrefix(t *testing.T) {
+	t.Parallel()
+
+	chain, err := ChainFromArgs(map[string]string{
+		"name":                    "nomic",
+		"lcd-endpoint":            "https://example.com",
+		"base-denom":              "unom",
+		"bech32-validator-prefix": "nomic",
+		"coin-type":               "119",
+	})
+	require.NoError(t, err)
+	require.Error(t, chain.Validate())
+	require.Equal(t, uint32(119), chain.Chain.CoinType)
+}
+
+func TestChainFromArgsInvalidCoinType(t *testing.T) {
+	t.Parallel()
+
+	_, err := ChainFromArgs(map[string]string{"name": "cosmos", "coin-type": "-1"})
+	require.Error(t, err)
+}
+
+func TestChainUpdateFromArgsMintModule(t *testing.T) {
+	t.Parallel()
+
+	chain := &Chain{Name: "osmosis", MintModule: constants.MintModuleStandard}
+	require.NoError(t, chain.UpdateFromArgs(map[string]string{"mint-module": "osmosis"}))
+	require.Equal(t, constants.MintModuleOsmosis, chain.MintModule)
+
+	require.Error(t, chain.UpdateFromArgs(map[string]string{"mint-module": "custom"}))
+	require.Equal(t, constants.MintModuleOsmosis, chain.MintModule)
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"main/pkg/constants"
+	"time"
+)
+
+// CometBFTResponse is a JSON-RPC response returned by the CometBFT RPC,
+// both via HTTP and websocket.
+type CometBFTResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *CometBFTError  `json:"error"`
+}
+
+type CometBFTError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data"`
+}
+
+func (e *CometBFTError) Error() string {
+	if e.Data != "" {
+		return fmt.Sprintf("%s: %s", e.Message, e.Data)
+	}
+
+	return e.Message
+}
+
+type CometBFTStatus struct {
+	NodeInfo struct {
+		Network string `json:"network"`
+		Version string `json:"version"`
+		Moniker string `json:"moniker"`
+	} `json:"node_info"`
+	SyncInfo struct {
+		LatestBlockHeight string    `json:"latest_block_height"`
+		LatestBlockTime   time.Time `json:"latest_block_time"`
+		CatchingUp        bool      `json:"catching_up"`
+	} `json:"sync_info"`
+}
+
+type CometBFTNetInfo struct {
+	Listening  bool   `json:"listening"`
+	PeersCount string `json:"n_peers"`
+	Peers      []struct {
+		NodeInfo struct {
+			ID      string `json:"id"`
+			Moniker string `json:"moniker"`
+		} `json:"node_info"`
+		RemoteIP string `json:"remote_ip"`
+	} `json:"peers"`
+}
+
+type CometBFTConsensusState struct {
+	RoundState struct {
+		HeightRoundStep   string    `json:"height/round/step"`
+		StartTime         time.Time `json:"start_time"`
+		ProposalBlockHash string    `json:"proposal_block_hash"`
+	} `json:"round_state"`
+}
+
+// CometBFTEvent is an event received via a websocket subscription, like NewBlock or Tx.
+// Data is left raw, as its structure depends on the event type.
+type CometBFTEvent struct {
+	Query string `json:"query"`
+	Data  struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	} `json:"data"`
+	Events map[string][]string `json:"events"`
+}
+
+func (e *CometBFTEvent) IsEmpty() bool {
+	return e.Query == "" && e.Data.Type == ""
+}
+
+// CometBFTNewBlock is the NewBlock event data. Only the last commit signatures are
+// parsed, as they are the ones needed to know who signed the previous block.
+type CometBFTNewBlock struct {
+	Block struct {
+		Header struct {
+			Height string    `json:"height"`
+			Time   time.Time `json:"time"`
+		} `json:"header"`
+		LastCommit struct {
+			Height     string                    `json:"height"`
+			Signatures []CometBFTCommitSignature `json:"signatures"`
+		} `json:"last_commit"`
+	} `json:"block"`
+}
+
+// CometBFTCommitSignature is a validator's vote in a commit. Signatures are ordered
+// the same way as the validator set, and absent ones have an empty validator address.
+type CometBFTCommitSignature struct {
+	BlockIDFlag      int    `json:"block_id_flag"`
+	ValidatorAddress string `json:"validator_address"`
+}
+
+func (s CometBFTCommitSignature) Signed() bool {
+	return s.BlockIDFlag != constants.BlockIDFlagAbsent
+}
+
+type CometBFTValidators struct {
+	BlockHeight string              `json:"block_height"`
+	Validators  []CometBFTValidator `json:"validators"`
+	Count       string              `json:"count"`
+	Total       string              `json:"total"`
+}
+
+type CometBFTValidator struct {
+	Address     string `json:"address"`
+	VotingPower string `json:"voting_power"`
+}
@@ -7,21 +7,83 @@ import (
 )
 
 type Config struct {
-	DatabaseConfig DatabaseConfig `toml:"database"`
-	LogConfig      LogConfig      `toml:"log"`
-	TelegramConfig TelegramConfig `toml:"telegram"`
-	MetricsConfig  MetricsConfig  `toml:"metrics"`
+	DatabaseConfig   DatabaseConfig   `toml:"database"`
+	LogConfig        LogConfig        `toml:"log"`
+	TelegramConfig   TelegramConfig   `toml:"telegram"`
+	MetricsConfig    MetricsConfig    `toml:"metrics"`
+	PaginationConfig PaginationConfig `toml:"pagination"`
+	WatcherConfig    WatcherConfig    `toml:"watcher"`
+	UptimeConfig     UptimeConfig     `toml:"uptime"`
+	UpgradesConfig   UpgradesConfig   `toml:"upgrades"`
+
+	DecentralizationConfig DecentralizationConfig `toml:"decentralization"`
+	ShutdownConfig         ShutdownConfig         `toml:"shutdown"`
+	NotifierConfig         NotifierConfig         `toml:"notifier"`
+	DigestsConfig          DigestsConfig          `toml:"digests"`
+	PriceAlertsConfig      PriceAlertsConfig      `toml:"price-alerts"`
+	WhaleAlertsConfig      WhaleAlertsConfig      `toml:"whale-alerts"`
+	ActiveSetConfig        ActiveSetConfig        `toml:"active-set"`
 }
 
 type TelegramConfig struct {
-	Token  string  `toml:"token"`
-	Admins []int64 `default:"[]" toml:"admins"`
+	Token   string                `toml:"token"`
+	Admins  []int64               `default:"[]" toml:"admins"`
+	Webhook TelegramWebhookConfig `toml:"webhook"`
 }
 
 func (c *Config) Validate() error {
 	if err := c.DatabaseConfig.Validate(); err != nil {
 		return fmt.Errorf("database config is invalid: %s", err)
 	}
+
+	if err := c.TelegramConfig.Webhook.Validate(); err != nil {
+		return fmt.Errorf("telegram webhook config is invalid: %s", err)
+	}
+
+	if err := c.PaginationConfig.Validate(); err != nil {
+		return fmt.Errorf("pagination config is invalid: %s", err)
+	}
+
+	if err := c.WatcherConfig.Validate(); err != nil {
+		return fmt.Errorf("watcher config is invalid: %s", err)
+	}
+
+	if err := c.UptimeConfig.Validate(); err != nil {
+		return fmt.Errorf("uptime config is invalid: %s", err)
+	}
+
+	if err := c.UpgradesConfig.Validate(); err != nil {
+		return fmt.Errorf("upgrades config is invalid: %s", err)
+	}
+
+	if err := c.DecentralizationConfig.Validate(); err != nil {
+		return fmt.Errorf("decentralization config is invalid: %s", err)
+	}
+
+	if err := c.ShutdownConfig.Validate(); err != nil {
+		return fmt.Errorf("shutdown config is invalid: %s", err)
+	}
+
+	if err := c.NotifierConfig.Validate(); err != nil {
+		return fmt.Errorf("notifier config is invalid: %s", err)
+	}
+
+	if err := c.DigestsConfig.Validate(); err != nil {
+		return fmt.Errorf("digests config is invalid: %s", err)
+	}
+
+	if err := c.PriceAlertsConfig.Validate(); err != nil {
+		return fmt.Errorf("price alerts config is invalid: %s", err)
+	}
+
+	if err := c.WhaleAlertsConfig.Validate(); err != nil {
+		return fmt.Errorf("whale alerts config is invalid: %s", err)
+	}
+
+	if err := c.ActiveSetConfig.Validate(); err != nil {
+		return fmt.Errorf("active set config is invalid: %s", err)
+	}
+
 	return nil
 }
 
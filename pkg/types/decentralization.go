@@ -0,0 +1,118 @@
+package types
+
+import (
+	"errors"
+	"main/pkg/utils"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/guregu/null/v5"
+
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type DecentralizationConfig struct {
+	Enabled  null.Bool     `default:"true" toml:"enabled"`
+	Interval time.Duration `default:"10m"  toml:"interval"`
+}
+
+func (c *DecentralizationConfig) Validate() error {
+	if c.Interval <= 0 {
+		return errors.New("interval should be positive")
+	}
+
+	return nil
+}
+
+// DecentralizationStats describes how the voting power is distributed
+// across the active validators of a chain.
+type DecentralizationStats struct {
+	// Minimal amount of validators having more than 1/3 of voting power, enough to halt the chain.
+	NakamotoCoefficient33 int
+	// Minimal amount of validators having more than 2/3 of voting power, enough to control the chain.
+	NakamotoCoefficient66 int
+	// 0 means the voting power is distributed equally, 1 means it all belongs to one validator.
+	GiniCoefficient float64
+	// Share of voting power of the 10 biggest validators.
+	Top10Share       float64
+	ActiveValidators int
+	MaxValidators    uint32
+}
+
+func NewDecentralizationStats(validators []stakingTypes.Validator, maxValidators uint32) *DecentralizationStats {
+	totalVP := utils.GetTotalVP(validators)
+
+	votingPowers := make([]math.LegacyDec, 0)
+	for _, validator := range validators {
+		if validator.Status == stakingTypes.Bonded {
+			votingPowers = append(votingPowers, validator.DelegatorShares)
+		}
+	}
+
+	sort.Slice(votingPowers, func(i, j int) bool {
+		return votingPowers[i].GT(votingPowers[j])
+	})
+
+	stats := &DecentralizationStats{
+		ActiveValidators: len(votingPowers),
+		MaxValidators:    maxValidators,
+	}
+
+	if totalVP.IsZero() {
+		return stats
+	}
+
+	oneThird := totalVP.QuoInt64(3)
+	twoThirds := totalVP.MulInt64(2).QuoInt64(3)
+	cumulative := math.LegacyZeroDec()
+	weightedSum := math.LegacyZeroDec()
+
+	for index, votingPower := range votingPowers {
+		cumulative = cumulative.Add(votingPower)
+
+		if stats.NakamotoCoefficient33 == 0 && cumulative.GT(oneThird) {
+			stats.NakamotoCoefficient33 = index + 1
+		}
+
+		if stats.NakamotoCoefficient66 == 0 && cumulative.GT(twoThirds) {
+			stats.NakamotoCoefficient66 = index + 1
+		}
+
+		if index == 9 {
+			stats.Top10Share = cumulative.Quo(totalVP).MustFloat64()
+		}
+
+		// validators are sorted descending, so the rank in the ascending order is n - index
+		weightedSum = weightedSum.Add(votingPower.MulInt64(int64(len(votingPowers) - index)))
+	}
+
+	if len(votingPowers) < 10 {
+		stats.Top10Share = 1
+	}
+
+	// Gini coefficient on values sorted ascending: 2 * sum(i * x_i) / (n * sum(x)) - (n + 1) / n
+	count := float64(len(votingPowers))
+	stats.GiniCoefficient = 2*weightedSum.Quo(totalVP).MustFloat64()/count - (count+1)/count
+
+	return stats
+}
+
+func (s DecentralizationStats) ActiveSetFilled() float64 {
+	if s.MaxValidators == 0 {
+		return 0
+	}
+
+	return float64(s.ActiveValidators) / float64(s.MaxValidators)
+}
+
+type ChainDecentralization struct {
+	Chain *Chain
+	Stats *DecentralizationStats
+	Error error
+}
+
+type ChainsDecentralization struct {
+	Error  error
+	Chains map[string]*ChainDecentralization
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDecentralizationConfigNoInterval(t *testing.T) {
+	t.Parallel()
+
+	config := &DecentralizationConfig{}
+	require.Error(t, config.Validate())
+}
+
+func TestValidateDecentralizationConfigOk(t *testing.T) {
+	t.Parallel()
+
+	config := &DecentralizationConfig{Interval: time.Minute}
+	require.NoError(t, config.Validate())
+}
+
+func TestDecentralizationStatsEmpty(t *testing.T) {
+	t.Parallel()
+
+	stats := NewDecentralizationStats([]stakingTypes.Validator{}, 100)
+	require.Zero(t, stats.NakamotoCoefficient33)
+	require.Zero(t, stats.ActiveValidators)
+	require.Zero(t, stats.ActiveSetFilled())
+}
+
+func TestDecentralizationStatsEqual(t *testing.T) {
+	t.Parallel()
+
+	validators := make([]stakingTypes.Validator, 12)
+	for index := range validators {
+		validators[index] = stakingTypes.Validator{
+			Status:          stakingTypes.Bonded,
+			DelegatorShares: math.LegacyNewDec(100),
+		}
+	}
+
+	validators = append(validators, stakingTypes.Validator{
+		Status:          stakingTypes.Unbonded,
+		DelegatorShares: math.LegacyNewDec(10000),
+	})
+
+	stats := NewDecentralizationStats(validators, 24)
+	require.Equal(t, 5, stats.NakamotoCoefficient33)
+	require.Equal(t, 9, stats.NakamotoCoefficient66)
+	require.InDelta(t, 0, stats.GiniCoefficient, 0.0001)
+	require.InDelta(t, 10.0/12, stats.Top10Share, 0.0001)
+	require.Equal(t, 12, stats.ActiveValidators)
+	require.InDelta(t, 0.5, stats.ActiveSetFilled(), 0.0001)
+}
+
+func TestDecentralizationStatsConcentrated(t *testing.T) {
+	t.Parallel()
+
+	stats := NewDecentralizationStats([]stakingTypes.Validator{
+		{Status: stakingTypes.Bonded, DelegatorShares: math.LegacyNewDec(70)},
+		{Status: stakingTypes.Bonded, DelegatorShares: math.LegacyNewDec(20)},
+		{Status: stakingTypes.Bonded, DelegatorShares: math.LegacyNewDec(10)},
+	}, 3)
+
+	require.Equal(t, 1, stats.NakamotoCoefficient33)
+	require.Equal(t, 1, stats.NakamotoCoefficient66)
+	require.InDelta(t, 0.4, stats.GiniCoefficient, 0.0001)
+	require.InDelta(t, 1, stats.Top10Share, 0.0001)
+}
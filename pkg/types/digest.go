@@ -0,0 +1,214 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guregu/null/v5"
+)
+
+type DigestsConfig struct {
+	Enabled  null.Bool     `default:"true" toml:"enabled"`
+	Interval time.Duration `default:"1m"   toml:"interval"`
+}
+
+func (c *DigestsConfig) Validate() error {
+	if c.Interval <= 0 {
+		return errors.New("interval should be positive")
+	}
+
+	return nil
+}
+
+type DigestFrequency string
+
+const (
+	DigestFrequencyDaily  DigestFrequency = "daily"
+	DigestFrequencyWeekly DigestFrequency = "weekly"
+)
+
+// DigestSubscription is a chat getting a summary of its bound chains at a scheduled time.
+type DigestSubscription struct {
+	Reporter  string
+	ChatID    string
+	Frequency DigestFrequency
+	// Time of the day to send the digest at, as minutes since midnight in the timezone.
+	Time     int64
+	Timezone string
+	// If set, the digest also has the balance changes of this user wallets.
+	BalancesUserID null.String
+	LastSentAt     time.Time
+	// Values at the moment of the previous digest, to compare the current ones with.
+	// Nil if no digest was sent yet.
+	Snapshot *DigestSnapshot
+}
+
+func (s *DigestSubscription) GetLocation() *time.Location {
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// LastScheduledTime returns the latest time the digest is scheduled at, not after now.
+// Weekly digests are sent on Mondays.
+func (s *DigestSubscription) LastScheduledTime(now time.Time) time.Time {
+	location := s.GetLocation()
+	local := now.In(location)
+
+	scheduled := time.Date(local.Year(), local.Month(), local.Day(), 0, int(s.Time), 0, 0, location)
+	if scheduled.After(local) {
+		scheduled = scheduled.AddDate(0, 0, -1)
+	}
+
+	if s.Frequency == DigestFrequencyWeekly {
+		daysSinceMonday := (int(scheduled.Weekday()) + 6) % 7
+		scheduled = scheduled.AddDate(0, 0, -daysSinceMonday)
+	}
+
+	return scheduled
+}
+
+func (s *DigestSubscription) NextScheduledTime(now time.Time) time.Time {
+	if s.Frequency == DigestFrequencyWeekly {
+		return s.LastScheduledTime(now).AddDate(0, 0, 7)
+	}
+
+	return s.LastScheduledTime(now).AddDate(0, 0, 1)
+}
+
+func (s *DigestSubscription) IsDue(now time.Time) bool {
+	return s.LastSentAt.Before(s.LastScheduledTime(now))
+}
+
+// Period is how far ahead the digest looks, for the proposals ending before the next one.
+func (s *DigestSubscription) Period() time.Duration {
+	if s.Frequency == DigestFrequencyWeekly {
+		return 7 * 24 * time.Hour
+	}
+
+	return 24 * time.Hour
+}
+
+func (s *DigestSubscription) FormatSchedule() string {
+	if s.Frequency == DigestFrequencyWeekly {
+		return fmt.Sprintf("weekly on Mondays at %s %s", FormatMinutesOfDay(s.Time), s.Timezone)
+	}
+
+	return fmt.Sprintf("daily at %s %s", FormatMinutesOfDay(s.Time), s.Timezone)
+}
+
+type DigestSnapshot struct {
+	Chains map[string]*ChainDigestSnapshot `json:"chains"`
+	// Wallet amounts by denom, keyed by GetDigestWalletKey.
+	Wallets map[string]map[string]float64 `json:"wallets"`
+}
+
+func (s *DigestSnapshot) GetChain(chainName string) *ChainDigestSnapshot {
+	if chain, ok := s.Chains[chainName]; ok {
+		return chain
+	}
+
+	return &ChainDigestSnapshot{}
+}
+
+type ChainDigestSnapshot struct {
+	Supply      null.Float `json:"supply"`
+	BondedRatio null.Float `json:"bonded_ratio"`
+	// Active validators monikers by their operator address.
+	ActiveSet map[string]string  `json:"active_set"`
+	Prices    map[string]float64 `json:"prices"`
+}
+
+func GetDigestWalletKey(chain, address string) string {
+	return chain + "/" + address
+}
+
+// DigestChange is a value compared with the one at the moment of the previous digest.
+type DigestChange struct {
+	Current     float64
+	Previous    float64
+	HasPrevious bool
+}
+
+func NewDigestChange(current float64, previous null.Float) DigestChange {
+	return DigestChange{
+		Current:     current,
+		Previous:    previous.Float64,
+		HasPrevious: previous.Valid,
+	}
+}
+
+func (c DigestChange) IsChanged() bool {
+	return c.HasPrevious && c.Current != c.Previous
+}
+
+func (c DigestChange) FormatPercentChange() string {
+	if !c.HasPrevious || c.Previous == 0 {
+		return "new"
+	}
+
+	return fmt.Sprintf("%+.2f%%", (c.Current-c.Previous)/c.Previous*100)
+}
+
+// FormatPointsChange formats the change of a ratio, in percentage points.
+func (c DigestChange) FormatPointsChange() string {
+	return fmt.Sprintf("%+.2f pp", (c.Current-c.Previous)*100)
+}
+
+type DigestPrice struct {
+	Denom string
+	Price DigestChange
+}
+
+func (p DigestPrice) FormatPrice() string {
+	return fmt.Sprintf("$%.4f", p.Price.Current)
+}
+
+type DigestAmount struct {
+	Denom  string
+	Amount DigestChange
+}
+
+type Digest struct {
+	Subscription *DigestSubscription
+	// The time of the previous digest, or of enabling it if it's the first one.
+	Since  time.Time
+	Chains []*ChainDigest
+
+	Wallets      []*WalletDigest
+	WalletsError error
+}
+
+type ChainDigest struct {
+	Chain     *Chain
+	Explorers Explorers
+
+	NewProposals    []*Proposal
+	EndingProposals []*Proposal
+	ProposalsError  error
+
+	Supply       *Amount
+	SupplyChange DigestChange
+	SupplyError  error
+
+	BondedRatio DigestChange
+	PoolError   error
+
+	ActiveSetSize   int
+	JoinedActiveSet []string
+	LeftActiveSet   []string
+	ValidatorsError error
+
+	Prices []*DigestPrice
+}
+
+type WalletDigest struct {
+	Chain   *Chain
+	Wallet  *WalletLink
+	Amounts []*DigestAmount
+	Error   error
+}
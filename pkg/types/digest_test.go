@@ -0,0 +1,126 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guregu/null/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDigestsConfigInvalidInterval(t *testing.T) {
+	t.Parallel()
+
+	config := &DigestsConfig{}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateDigestsConfigOk(t *testing.T) {
+	t.Parallel()
+
+	config := &DigestsConfig{Interval: time.Minute}
+	err := config.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
+
+func TestDigestSubscriptionDaily(t *testing.T) {
+	t.Parallel()
+
+	subscription := &DigestSubscription{
+		Frequency:  DigestFrequencyDaily,
+		Time:       9 * 60,
+		Timezone:   "UTC",
+		LastSentAt: time.Date(2025, 1, 19, 9, 0, 0, 0, time.UTC),
+	}
+
+	require.Equal(t, "daily at 09:00 UTC", subscription.FormatSchedule())
+	require.Equal(t, 24*time.Hour, subscription.Period())
+
+	beforeTime := time.Date(2025, 1, 20, 8, 59, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2025, 1, 19, 9, 0, 0, 0, time.UTC), subscription.LastScheduledTime(beforeTime))
+	require.Equal(t, time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC), subscription.NextScheduledTime(beforeTime))
+	require.False(t, subscription.IsDue(beforeTime))
+
+	require.True(t, subscription.IsDue(time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC)))
+}
+
+func TestDigestSubscriptionWeekly(t *testing.T) {
+	t.Parallel()
+
+	subscription := &DigestSubscription{
+		Frequency:  DigestFrequencyWeekly,
+		Time:       9 * 60,
+		Timezone:   "UTC",
+		LastSentAt: time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC),
+	}
+
+	require.Equal(t, "weekly on Mondays at 09:00 UTC", subscription.FormatSchedule())
+	require.Equal(t, 7*24*time.Hour, subscription.Period())
+
+	// 2025-01-19 is a Sunday.
+	sunday := time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC), subscription.LastScheduledTime(sunday))
+	require.Equal(t, time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC), subscription.NextScheduledTime(sunday))
+	require.False(t, subscription.IsDue(sunday))
+
+	require.True(t, subscription.IsDue(time.Date(2025, 1, 20, 9, 30, 0, 0, time.UTC)))
+}
+
+func TestDigestSubscriptionTimezone(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	subscription := &DigestSubscription{
+		Frequency:  DigestFrequencyDaily,
+		Time:       9 * 60,
+		Timezone:   "Asia/Tokyo",
+		LastSentAt: time.Date(2025, 1, 19, 0, 0, 0, 0, time.UTC),
+	}
+
+	// 00:30 UTC is 09:30 in Tokyo.
+	now := time.Date(2025, 1, 20, 0, 30, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2025, 1, 20, 9, 0, 0, 0, tokyo), subscription.LastScheduledTime(now))
+	require.True(t, subscription.IsDue(now))
+}
+
+func TestDigestSubscriptionInvalidTimezone(t *testing.T) {
+	t.Parallel()
+
+	subscription := &DigestSubscription{Timezone: "Mars/Olympus"}
+	require.Equal(t, time.UTC, subscription.GetLocation())
+}
+
+func TestDigestSnapshotGetChain(t *testing.T) {
+	t.Parallel()
+
+	snapshot := &DigestSnapshot{Chains: map[string]*ChainDigestSnapshot{
+		"chain": {Supply: null.FloatFrom(100)},
+	}}
+
+	require.Equal(t, null.FloatFrom(100), snapshot.GetChain("chain").Supply)
+	require.False(t, snapshot.GetChain("other").Supply.Valid)
+}
+
+func TestDigestChange(t *testing.T) {
+	t.Parallel()
+
+	change := NewDigestChange(110, null.FloatFrom(100))
+	require.True(t, change.IsChanged())
+	require.Equal(t, "+10.00%", change.FormatPercentChange())
+
+	ratioChange := NewDigestChange(0.65, null.FloatFrom(0.66))
+	require.Equal(t, "-1.00 pp", ratioChange.FormatPointsChange())
+
+	newChange := NewDigestChange(10, null.FloatFrom(0))
+	require.Equal(t, "new", newChange.FormatPercentChange())
+
+	noPrevious := NewDigestChange(10, null.Float{})
+	require.False(t, noPrevious.IsChanged())
+	require.Equal(t, "new", noPrevious.FormatPercentChange())
+
+	price := DigestPrice{Denom: "ATOM", Price: NewDigestChange(6.5, null.Float{})}
+	require.Equal(t, "$6.5000", price.FormatPrice())
+}
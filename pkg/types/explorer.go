@@ -13,6 +13,7 @@ type Explorer struct {
 	WalletLinkPattern    string
 	ValidatorLinkPattern string
 	MainLink             string
+	TxLinkPattern        string
 }
 
 func ExplorerFromArgs(args map[string]string) *Explorer {
@@ -36,6 +37,8 @@ func ExplorerFromArgs(args map[string]string) *Explorer {
 			explorer.ValidatorLinkPattern = value
 		case "main-link":
 			explorer.MainLink = value
+		case "tx-link-pattern":
+			explorer.TxLinkPattern = value
 		}
 	}
 
@@ -57,6 +60,7 @@ func MintscanExplorerFromArgs(args map[string]string) *Explorer {
 		WalletLinkPattern:    fmt.Sprintf("https://mintscan.io/%s/account/%%s", prefix),
 		ValidatorLinkPattern: fmt.Sprintf("https://mintscan.io/%s/validators/%%s", prefix),
 		MainLink:             fmt.Sprintf("https://mintscan.io/%s", prefix),
+		TxLinkPattern:        fmt.Sprintf("https://mintscan.io/%s/tx/%%s", prefix),
 	}
 }
 
@@ -80,6 +84,7 @@ func PingExplorerFromArgs(args map[string]string) *Explorer {
 		WalletLinkPattern:    fmt.Sprintf("%s/%s/account/%%s", host, prefix),
 		ValidatorLinkPattern: fmt.Sprintf("%s/%s/staking/%%s", host, prefix),
 		MainLink:             fmt.Sprintf("%s/%s", host, prefix),
+		TxLinkPattern:        fmt.Sprintf("%s/%s/tx/%%s", host, prefix),
 	}
 }
 
@@ -190,3 +195,19 @@ func (e Explorers) GetWalletLinks(wallet *WalletLink) []Link {
 
 	return links
 }
+
+func (e Explorers) GetTxLinks(hash string) []Link {
+	links := make([]Link, 0)
+	for _, explorer := range e {
+		if explorer.TxLinkPattern == "" {
+			continue
+		}
+
+		links = append(links, Link{
+			Text: explorer.Name,
+			Href: fmt.Sprintf(explorer.TxLinkPattern, hash),
+		})
+	}
+
+	return links
+}
@@ -29,3 +29,16 @@ func TestExplorerDisplayWarningsOk(t *testing.T) {
 	warnings := explorer.DisplayWarnings("test")
 	assert.Empty(t, warnings)
 }
+
+func TestExplorerGetTxLinks(t *testing.T) {
+	t.Parallel()
+
+	explorers := Explorers{
+		{Name: "Ping", TxLinkPattern: "https://example.com/tx/%s"},
+		{Name: "Mintscan"},
+	}
+
+	links := explorers.GetTxLinks("hash")
+	assert.Len(t, links, 1)
+	assert.Equal(t, "https://example.com/tx/hash", links[0].Href)
+}
@@ -0,0 +1,25 @@
+package types
+
+import "time"
+
+// JobStatus is a scheduled job state as seen by this replica.
+type JobStatus struct {
+	Name      string
+	Interval  time.Duration
+	IsLeader  bool
+	IsRunning bool
+
+	LastRunTime     time.Time
+	LastRunDuration time.Duration
+	LastError       string
+	Runs            int64
+	Failures        int64
+}
+
+func (s JobStatus) HasRun() bool {
+	return !s.LastRunTime.IsZero()
+}
+
+func (s JobStatus) FormatLastRunDuration() string {
+	return s.LastRunDuration.Round(time.Millisecond).String()
+}
@@ -0,0 +1,234 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/guregu/null/v5"
+)
+
+type NotificationType string
+
+const (
+	NotificationTypeTransfer  NotificationType = "transfer"
+	NotificationTypeUpgrade   NotificationType = "upgrade"
+	NotificationTypeWhale     NotificationType = "whale"
+	NotificationTypeActiveSet NotificationType = "active_set"
+	// Digests and price alerts are set up by the chats and users themselves,
+	// so they cannot be muted.
+	NotificationTypeDigest     NotificationType = "digest"
+	NotificationTypePriceAlert NotificationType = "price_alert"
+)
+
+var NotificationTypes = []NotificationType{
+	NotificationTypeTransfer,
+	NotificationTypeUpgrade,
+	NotificationTypeWhale,
+	NotificationTypeActiveSet,
+}
+
+func IsNotificationType(value string) bool {
+	return slices.Contains(NotificationTypes, NotificationType(value))
+}
+
+type NotificationMode string
+
+const (
+	NotificationModeInstant NotificationMode = "instant"
+	NotificationModeDigest  NotificationMode = "digest"
+)
+
+// NotificationEvent is something happening on a chain that users should be notified about.
+type NotificationEvent struct {
+	Type  NotificationType
+	Chain string
+	// Users having this wallet or validator linked are notified about the event.
+	// If none of them are set, the chats bound to the chain are notified.
+	WalletAddress    string
+	ValidatorAddress string
+	// Template to render the event with, and the data it is rendered with.
+	Template string
+	Data     any
+}
+
+// NotificationRecipient is a chat, or a private chat with a user,
+// and the interacter it came from.
+type NotificationRecipient struct {
+	Reporter string
+	ChatID   string
+}
+
+// QueuedNotification is a rendered notification waiting to be delivered,
+// as the chat has digest mode or quiet hours enabled.
+type QueuedNotification struct {
+	ID        int64
+	Reporter  string
+	ChatID    string
+	Type      NotificationType
+	Chain     string
+	Text      string
+	CreatedAt time.Time
+}
+
+type NotificationSettings struct {
+	Reporter    string
+	ChatID      string
+	MutedTypes  []string
+	MutedChains []string
+	Mode        NotificationMode
+	Timezone    string
+	// Quiet hours start and end, as minutes since midnight in the chat timezone.
+	QuietHoursStart null.Int
+	QuietHoursEnd   null.Int
+}
+
+func NewNotificationSettings(reporter, chatID string) *NotificationSettings {
+	return &NotificationSettings{
+		Reporter:    reporter,
+		ChatID:      chatID,
+		MutedTypes:  []string{},
+		MutedChains: []string{},
+		Mode:        NotificationModeInstant,
+		Timezone:    "UTC",
+	}
+}
+
+func (s *NotificationSettings) IsMuted(event *NotificationEvent) bool {
+	return slices.Contains(s.MutedTypes, string(event.Type)) || slices.Contains(s.MutedChains, event.Chain)
+}
+
+func (s *NotificationSettings) IsDigest() bool {
+	return s.Mode == NotificationModeDigest
+}
+
+func (s *NotificationSettings) HasQuietHours() bool {
+	return s.QuietHoursStart.Valid && s.QuietHoursEnd.Valid
+}
+
+func (s *NotificationSettings) GetLocation() *time.Location {
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// IsQuietTime returns whether the time is within quiet hours in the chat timezone.
+// Quiet hours can span midnight, like 23:00-07:00.
+func (s *NotificationSettings) IsQuietTime(now time.Time) bool {
+	if !s.HasQuietHours() || s.QuietHoursStart.Int64 == s.QuietHoursEnd.Int64 {
+		return false
+	}
+
+	local := now.In(s.GetLocation())
+	minutes := int64(local.Hour()*60 + local.Minute())
+	start, end := s.QuietHoursStart.Int64, s.QuietHoursEnd.Int64
+
+	if start < end {
+		return minutes >= start && minutes < end
+	}
+
+	return minutes >= start || minutes < end
+}
+
+func (s *NotificationSettings) FormatQuietHours() string {
+	if !s.HasQuietHours() {
+		return "off"
+	}
+
+	return fmt.Sprintf(
+		"%s-%s %s",
+		FormatMinutesOfDay(s.QuietHoursStart.Int64),
+		FormatMinutesOfDay(s.QuietHoursEnd.Int64),
+		s.Timezone,
+	)
+}
+
+func (s *NotificationSettings) FormatMutedTypes() string {
+	return formatListOrNone(s.MutedTypes)
+}
+
+func (s *NotificationSettings) FormatMutedChains() string {
+	return formatListOrNone(s.MutedChains)
+}
+
+func formatListOrNone(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+
+	return strings.Join(values, ", ")
+}
+
+func FormatNotificationTypes() string {
+	values := make([]string, len(NotificationTypes))
+	for index, notificationType := range NotificationTypes {
+		values[index] = string(notificationType)
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// Mute adds a type or a chain to the muted list, returns false if it was already muted.
+func (s *NotificationSettings) Mute(value string) bool {
+	if IsNotificationType(value) {
+		if slices.Contains(s.MutedTypes, value) {
+			return false
+		}
+
+		s.MutedTypes = append(s.MutedTypes, value)
+		return true
+	}
+
+	if slices.Contains(s.MutedChains, value) {
+		return false
+	}
+
+	s.MutedChains = append(s.MutedChains, value)
+	return true
+}
+
+// Unmute removes a type or a chain from the muted lists, returns false if it was not muted.
+func (s *NotificationSettings) Unmute(value string) bool {
+	typesCount, chainsCount := len(s.MutedTypes), len(s.MutedChains)
+
+	s.MutedTypes = slices.DeleteFunc(s.MutedTypes, func(t string) bool { return t == value })
+	s.MutedChains = slices.DeleteFunc(s.MutedChains, func(c string) bool { return c == value })
+
+	return len(s.MutedTypes) != typesCount || len(s.MutedChains) != chainsCount
+}
+
+func FormatMinutesOfDay(minutes int64) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// ParseMinutesOfDay parses a time like 23:30 into minutes since midnight.
+func ParseMinutesOfDay(value string) (int64, error) {
+	parsed, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, errors.New("time should be in HH:MM format")
+	}
+
+	return int64(parsed.Hour()*60 + parsed.Minute()), nil
+}
+
+type NotifierConfig struct {
+	FlushInterval  time.Duration `default:"1m" toml:"flush-interval"`
+	DigestInterval time.Duration `default:"1h" toml:"digest-interval"`
+}
+
+func (c *NotifierConfig) Validate() error {
+	if c.FlushInterval <= 0 {
+		return errors.New("flush interval should be positive")
+	}
+
+	if c.DigestInterval <= 0 {
+		return errors.New("digest interval should be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guregu/null/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsNotificationType(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, IsNotificationType("upgrade"))
+	require.False(t, IsNotificationType("chain1"))
+}
+
+func TestNotificationSettingsIsMuted(t *testing.T) {
+	t.Parallel()
+
+	settings := NewNotificationSettings("telegram", "1")
+	settings.MutedTypes = []string{"upgrade"}
+	settings.MutedChains = []string{"chain1"}
+
+	require.True(t, settings.IsMuted(&NotificationEvent{Type: NotificationTypeUpgrade, Chain: "chain2"}))
+	require.True(t, settings.IsMuted(&NotificationEvent{Type: NotificationTypeTransfer, Chain: "chain1"}))
+	require.False(t, settings.IsMuted(&NotificationEvent{Type: NotificationTypeTransfer, Chain: "chain2"}))
+}
+
+func TestNotificationSettingsMuteUnmute(t *testing.T) {
+	t.Parallel()
+
+	settings := NewNotificationSettings("telegram", "1")
+
+	require.True(t, settings.Mute("upgrade"))
+	require.False(t, settings.Mute("upgrade"))
+	require.True(t, settings.Mute("chain1"))
+	require.False(t, settings.Mute("chain1"))
+	require.Equal(t, []string{"upgrade"}, settings.MutedTypes)
+	require.Equal(t, []string{"chain1"}, settings.MutedChains)
+	require.Equal(t, "upgrade", settings.FormatMutedTypes())
+
+	require.True(t, settings.Unmute("upgrade"))
+	require.False(t, settings.Unmute("upgrade"))
+	require.True(t, settings.Unmute("chain1"))
+	require.False(t, settings.Unmute("chain2"))
+	require.Empty(t, settings.MutedTypes)
+	require.Empty(t, settings.MutedChains)
+	require.Equal(t, "none", settings.FormatMutedChains())
+}
+
+func TestNotificationSettingsIsQuietTimeNoQuietHours(t *testing.T) {
+	t.Parallel()
+
+	settings := NewNotificationSettings("telegram", "1")
+	require.False(t, settings.IsQuietTime(time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC)))
+	require.Equal(t, "off", settings.FormatQuietHours())
+}
+
+func TestNotificationSettingsIsQuietTimeSameDay(t *testing.T) {
+	t.Parallel()
+
+	settings := NewNotificationSettings("telegram", "1")
+	settings.QuietHoursStart = null.IntFrom(13 * 60)
+	settings.QuietHoursEnd = null.IntFrom(14*60 + 30)
+
+	require.False(t, settings.IsQuietTime(time.Date(2024, 1, 1, 12, 59, 0, 0, time.UTC)))
+	require.True(t, settings.IsQuietTime(time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC)))
+	require.True(t, settings.IsQuietTime(time.Date(2024, 1, 1, 14, 29, 0, 0, time.UTC)))
+	require.False(t, settings.IsQuietTime(time.Date(2024, 1, 1, 14, 30, 0, 0, time.UTC)))
+	require.Equal(t, "13:00-14:30 UTC", settings.FormatQuietHours())
+}
+
+func TestNotificationSettingsIsQuietTimeOverMidnight(t *testing.T) {
+	t.Parallel()
+
+	settings := NewNotificationSettings("telegram", "1")
+	settings.QuietHoursStart = null.IntFrom(23 * 60)
+	settings.QuietHoursEnd = null.IntFrom(7 * 60)
+
+	require.True(t, settings.IsQuietTime(time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC)))
+	require.True(t, settings.IsQuietTime(time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC)))
+	require.False(t, settings.IsQuietTime(time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)))
+	require.False(t, settings.IsQuietTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)))
+}
+
+func TestNotificationSettingsIsQuietTimeTimezone(t *testing.T) {
+	t.Parallel()
+
+	settings := NewNotificationSettings("telegram", "1")
+	settings.Timezone = "Asia/Tokyo"
+	settings.QuietHoursStart = null.IntFrom(22 * 60)
+	settings.QuietHoursEnd = null.IntFrom(8 * 60)
+
+	// 14:00 UTC is 23:00 in Tokyo.
+	require.True(t, settings.IsQuietTime(time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC)))
+	require.False(t, settings.IsQuietTime(time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC)))
+}
+
+func TestNotificationSettingsGetLocationInvalid(t *testing.T) {
+	t.Parallel()
+
+	settings := NewNotificationSettings("telegram", "1")
+	settings.Timezone = "Mars/Olympus"
+	require.Equal(t, time.UTC, settings.GetLocation())
+}
+
+func TestParseMinutesOfDay(t *testing.T) {
+	t.Parallel()
+
+	minutes, err := ParseMinutesOfDay("23:30")
+	require.NoError(t, err)
+	require.Equal(t, int64(23*60+30), minutes)
+	require.Equal(t, "23:30", FormatMinutesOfDay(minutes))
+
+	_, err = ParseMinutesOfDay("25:00")
+	require.Error(t, err)
+}
+
+func TestValidateNotifierConfigInvalidFlushInterval(t *testing.T) {
+	t.Parallel()
+
+	config := &NotifierConfig{DigestInterval: time.Hour}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateNotifierConfigInvalidDigestInterval(t *testing.T) {
+	t.Parallel()
+
+	config := &NotifierConfig{FlushInterval: time.Minute}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateNotifierConfigOk(t *testing.T) {
+	t.Parallel()
+
+	config := &NotifierConfig{FlushInterval: time.Minute, DigestInterval: time.Hour}
+	err := config.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
@@ -0,0 +1,20 @@
+package types
+
+import "errors"
+
+type PaginationConfig struct {
+	PageSize uint64 `default:"1000" toml:"page-size"`
+	MaxPages int    `default:"100"  toml:"max-pages"`
+}
+
+func (c *PaginationConfig) Validate() error {
+	if c.PageSize == 0 {
+		return errors.New("page size should be positive")
+	}
+
+	if c.MaxPages <= 0 {
+		return errors.New("max pages should be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePaginationConfigNoPageSize(t *testing.T) {
+	t.Parallel()
+
+	config := &PaginationConfig{MaxPages: 10}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidatePaginationConfigNoMaxPages(t *testing.T) {
+	t.Parallel()
+
+	config := &PaginationConfig{PageSize: 100}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidatePaginationConfigOk(t *testing.T) {
+	t.Parallel()
+
+	config := &PaginationConfig{PageSize: 100, MaxPages: 10}
+	err := config.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/guregu/null/v5"
+)
+
+type PriceAlertsConfig struct {
+	Enabled  null.Bool     `default:"true" toml:"enabled"`
+	Interval time.Duration `default:"1m"   toml:"interval"`
+}
+
+func (c *PriceAlertsConfig) Validate() error {
+	if c.Interval <= 0 {
+		return errors.New("interval should be positive")
+	}
+
+	return nil
+}
+
+type PriceAlertDirection string
+
+const (
+	PriceAlertDirectionAbove PriceAlertDirection = "above"
+	PriceAlertDirectionBelow PriceAlertDirection = "below"
+)
+
+// PriceAlert is a user waiting for a denom price to cross the target.
+// Once it's crossed, the user gets a private message and the alert is not armed anymore,
+// until the user re-arms it.
+type PriceAlert struct {
+	ID        int64
+	Reporter  string
+	UserID    string
+	Chain     string
+	Denom     string
+	Direction PriceAlertDirection
+	// Target price, in USD.
+	Target      float64
+	TriggeredAt null.Time
+	CreatedAt   time.Time
+
+	// Set when the alert is displayed, nil if the denom is not found.
+	DenomInfo *Denom
+}
+
+func (a *PriceAlert) IsArmed() bool {
+	return !a.TriggeredAt.Valid
+}
+
+func (a *PriceAlert) IsCrossed(price float64) bool {
+	if a.Direction == PriceAlertDirectionAbove {
+		return price >= a.Target
+	}
+
+	return price <= a.Target
+}
+
+func (a *PriceAlert) GetDisplayDenom() string {
+	if a.DenomInfo == nil {
+		return a.Denom
+	}
+
+	return a.DenomInfo.DisplayDenom
+}
+
+func (a *PriceAlert) FormatTarget() string {
+	return "$" + strconv.FormatFloat(a.Target, 'f', -1, 64)
+}
+
+// DenomPrice is a denom USD price and its 24h change in percents,
+// which is not set if the price source does not support it or it's not requested.
+type DenomPrice struct {
+	Denom     *Denom
+	Price     null.Float
+	Change24h null.Float
+}
+
+func (p *DenomPrice) FormatPrice() string {
+	return fmt.Sprintf("$%.4f", p.Price.Float64)
+}
+
+func (p *DenomPrice) FormatChange() string {
+	return fmt.Sprintf("%+.2f%%", p.Change24h.Float64)
+}
+
+type ChainPrices struct {
+	Chain  *Chain
+	Prices []*DenomPrice
+	Error  error
+}
+
+type PricesInfo struct {
+	Chains []*ChainPrices
+	Error  error
+}
+
+type PriceAlertNotification struct {
+	Alert *PriceAlert
+	Price *DenomPrice
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guregu/null/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePriceAlertsConfigInvalidInterval(t *testing.T) {
+	t.Parallel()
+
+	config := &PriceAlertsConfig{}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidatePriceAlertsConfigOk(t *testing.T) {
+	t.Parallel()
+
+	config := &PriceAlertsConfig{Interval: time.Minute}
+	err := config.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
+
+func TestPriceAlertIsCrossed(t *testing.T) {
+	t.Parallel()
+
+	above := &PriceAlert{Direction: PriceAlertDirectionAbove, Target: 10}
+	require.False(t, above.IsCrossed(9.99))
+	require.True(t, above.IsCrossed(10))
+	require.True(t, above.IsCrossed(11))
+
+	below := &PriceAlert{Direction: PriceAlertDirectionBelow, Target: 10}
+	require.True(t, below.IsCrossed(9.99))
+	require.True(t, below.IsCrossed(10))
+	require.False(t, below.IsCrossed(11))
+}
+
+func TestPriceAlertIsArmed(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, (&PriceAlert{}).IsArmed())
+	require.False(t, (&PriceAlert{TriggeredAt: null.TimeFrom(time.Now())}).IsArmed())
+}
+
+func TestPriceAlertGetDisplayDenom(t *testing.T) {
+	t.Parallel()
+
+	alert := &PriceAlert{Denom: "uatom"}
+	require.Equal(t, "uatom", alert.GetDisplayDenom())
+
+	alert.DenomInfo = &Denom{Denom: "uatom", DisplayDenom: "ATOM"}
+	require.Equal(t, "ATOM", alert.GetDisplayDenom())
+}
+
+func TestPriceAlertFormatTarget(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "$10", (&PriceAlert{Target: 10}).FormatTarget())
+	require.Equal(t, "$0.0123", (&PriceAlert{Target: 0.0123}).FormatTarget())
+}
+
+func TestDenomPriceFormat(t *testing.T) {
+	t.Parallel()
+
+	price := &DenomPrice{Price: null.FloatFrom(7.13), Change24h: null.FloatFrom(-2.4567)}
+	require.Equal(t, "$7.1300", price.FormatPrice())
+	require.Equal(t, "-2.46%", price.FormatChange())
+
+	price.Change24h = null.FloatFrom(1)
+	require.Equal(t, "+1.00%", price.FormatChange())
+}
@@ -0,0 +1,150 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	upgradeTypes "cosmossdk.io/x/upgrade/types"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	distributionTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govV1beta1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramsProposalTypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// ProposalStatusFilters are the statuses proposals can be searched by,
+// "all" meaning no status filter.
+var ProposalStatusFilters = map[string]govV1Types.ProposalStatus{
+	"all":      govV1Types.StatusNil,
+	"deposit":  govV1Types.StatusDepositPeriod,
+	"voting":   govV1Types.StatusVotingPeriod,
+	"passed":   govV1Types.StatusPassed,
+	"rejected": govV1Types.StatusRejected,
+}
+
+type ProposalsSearch struct {
+	Chain      *Chain
+	Explorers  Explorers
+	Status     string
+	Query      string
+	Proposals  []*Proposal
+	Page       int
+	PagesCount int
+	TotalCount int
+	Error      error
+}
+
+func (s ProposalsSearch) HasNextPage() bool {
+	return s.Page < s.PagesCount
+}
+
+func (s ProposalsSearch) NextPage() int {
+	return s.Page + 1
+}
+
+type ProposalTally struct {
+	Yes        math.Int
+	No         math.Int
+	Abstain    math.Int
+	NoWithVeto math.Int
+}
+
+func ProposalTallyFromV1(tally *govV1Types.TallyResult) (*ProposalTally, error) {
+	amounts := make([]math.Int, 4)
+
+	for index, count := range []string{tally.YesCount, tally.NoCount, tally.AbstainCount, tally.NoWithVetoCount} {
+		amount, ok := math.NewIntFromString(count)
+		if !ok {
+			return nil, fmt.Errorf("invalid tally votes count: %s", count)
+		}
+
+		amounts[index] = amount
+	}
+
+	return &ProposalTally{
+		Yes:        amounts[0],
+		No:         amounts[1],
+		Abstain:    amounts[2],
+		NoWithVeto: amounts[3],
+	}, nil
+}
+
+func ProposalTallyFromV1beta1(tally govV1beta1Types.TallyResult) *ProposalTally {
+	return &ProposalTally{
+		Yes:        tally.Yes,
+		No:         tally.No,
+		Abstain:    tally.Abstain,
+		NoWithVeto: tally.NoWithVeto,
+	}
+}
+
+func (t *ProposalTally) Total() math.Int {
+	return t.Yes.Add(t.No).Add(t.Abstain).Add(t.NoWithVeto)
+}
+
+func (t *ProposalTally) ratio(votes, total math.Int) float64 {
+	if total.IsZero() {
+		return 0
+	}
+
+	return votes.ToLegacyDec().Quo(total.ToLegacyDec()).MustFloat64()
+}
+
+func (t *ProposalTally) YesRatio() float64        { return t.ratio(t.Yes, t.Total()) }
+func (t *ProposalTally) NoRatio() float64         { return t.ratio(t.No, t.Total()) }
+func (t *ProposalTally) AbstainRatio() float64    { return t.ratio(t.Abstain, t.Total()) }
+func (t *ProposalTally) NoWithVetoRatio() float64 { return t.ratio(t.NoWithVeto, t.Total()) }
+
+// YesWithoutAbstainRatio is the share of Yes votes among the votes that are not Abstain,
+// which is what is compared to the threshold when tallying.
+func (t *ProposalTally) YesWithoutAbstainRatio() float64 {
+	return t.ratio(t.Yes, t.Total().Sub(t.Abstain))
+}
+
+// ProposalMessage is a proposal message, or a legacy proposal content,
+// with a human-readable summary for the message types we know about.
+type ProposalMessage struct {
+	Type    string
+	Summary string
+}
+
+func ProposalMessageFrom(message *codecTypes.Any) *ProposalMessage {
+	switch msg := message.GetCachedValue().(type) {
+	case *govV1Types.MsgExecLegacyContent:
+		if msg.Content != nil {
+			return ProposalMessageFrom(msg.Content)
+		}
+	case *upgradeTypes.MsgSoftwareUpgrade:
+		return &ProposalMessage{
+			Type:    message.TypeUrl,
+			Summary: fmt.Sprintf("upgrade %s at height %d", msg.Plan.Name, msg.Plan.Height),
+		}
+	case *upgradeTypes.SoftwareUpgradeProposal: //nolint:staticcheck
+		return &ProposalMessage{
+			Type:    message.TypeUrl,
+			Summary: fmt.Sprintf("upgrade %s at height %d", msg.Plan.Name, msg.Plan.Height),
+		}
+	case *upgradeTypes.MsgCancelUpgrade:
+		return &ProposalMessage{Type: message.TypeUrl, Summary: "cancel the upgrade"}
+	case *distributionTypes.MsgCommunityPoolSpend:
+		return &ProposalMessage{
+			Type:    message.TypeUrl,
+			Summary: fmt.Sprintf("send %s to %s", msg.Amount, msg.Recipient),
+		}
+	case *distributionTypes.CommunityPoolSpendProposal: //nolint:staticcheck
+		return &ProposalMessage{
+			Type:    message.TypeUrl,
+			Summary: fmt.Sprintf("send %s to %s", msg.Amount, msg.Recipient),
+		}
+	case *paramsProposalTypes.ParameterChangeProposal:
+		changes := make([]string, len(msg.Changes))
+		for index, change := range msg.Changes {
+			changes[index] = fmt.Sprintf("%s/%s = %s", change.Subspace, change.Key, change.Value)
+		}
+
+		return &ProposalMessage{Type: message.TypeUrl, Summary: strings.Join(changes, ", ")}
+	}
+
+	return &ProposalMessage{Type: message.TypeUrl}
+}
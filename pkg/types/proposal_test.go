@@ -0,0 +1,103 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govV1beta1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramsProposalTypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalTallyFromV1Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ProposalTallyFromV1(&govV1Types.TallyResult{
+		YesCount:        "invalid",
+		NoCount:         "0",
+		AbstainCount:    "0",
+		NoWithVetoCount: "0",
+	})
+	require.Error(t, err)
+}
+
+func TestProposalTallyRatios(t *testing.T) {
+	t.Parallel()
+
+	tally, err := ProposalTallyFromV1(&govV1Types.TallyResult{
+		YesCount:        "50",
+		NoCount:         "20",
+		AbstainCount:    "20",
+		NoWithVetoCount: "10",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(100), tally.Total())
+	require.InDelta(t, 0.5, tally.YesRatio(), 0.0001)
+	require.InDelta(t, 0.2, tally.NoRatio(), 0.0001)
+	require.InDelta(t, 0.2, tally.AbstainRatio(), 0.0001)
+	require.InDelta(t, 0.1, tally.NoWithVetoRatio(), 0.0001)
+	require.InDelta(t, 0.625, tally.YesWithoutAbstainRatio(), 0.0001)
+
+	empty := ProposalTallyFromV1beta1(govV1beta1Types.TallyResult{
+		Yes:        math.ZeroInt(),
+		No:         math.ZeroInt(),
+		Abstain:    math.ZeroInt(),
+		NoWithVeto: math.ZeroInt(),
+	})
+	require.Zero(t, empty.YesRatio())
+	require.Zero(t, empty.YesWithoutAbstainRatio())
+}
+
+func TestSingleProposalThresholds(t *testing.T) {
+	t.Parallel()
+
+	proposal := SingleProposal{
+		Tally: &ProposalTally{
+			Yes:        math.NewInt(30),
+			No:         math.NewInt(10),
+			Abstain:    math.NewInt(5),
+			NoWithVeto: math.NewInt(5),
+		},
+		BondedTokens: math.NewInt(200),
+		TallyParams: govV1beta1Types.TallyParams{
+			Quorum:        math.LegacyMustNewDecFromStr("0.4"),
+			Threshold:     math.LegacyMustNewDecFromStr("0.5"),
+			VetoThreshold: math.LegacyMustNewDecFromStr("0.334"),
+		},
+	}
+
+	require.True(t, proposal.HasTallyInfo())
+	require.InDelta(t, 0.25, proposal.Turnout(), 0.0001)
+	require.False(t, proposal.QuorumReached())
+	require.True(t, proposal.ThresholdReached())
+	require.False(t, proposal.VetoReached())
+}
+
+func TestProposalMessageFrom(t *testing.T) {
+	t.Parallel()
+
+	content, err := codecTypes.NewAnyWithValue(&paramsProposalTypes.ParameterChangeProposal{
+		Changes: []paramsProposalTypes.ParamChange{
+			{Subspace: "mint", Key: "InflationMax", Value: "0.1"},
+			{Subspace: "staking", Key: "MaxValidators", Value: "200"},
+		},
+	})
+	require.NoError(t, err)
+
+	legacyMsg, err := codecTypes.NewAnyWithValue(&govV1Types.MsgExecLegacyContent{Content: content})
+	require.NoError(t, err)
+
+	message := ProposalMessageFrom(legacyMsg)
+	require.Equal(t, "/cosmos.params.v1beta1.ParameterChangeProposal", message.Type)
+	require.Equal(t, "mint/InflationMax = 0.1, staking/MaxValidators = 200", message.Summary)
+
+	voteMsg, err := codecTypes.NewAnyWithValue(&govV1Types.MsgVote{ProposalId: 1})
+	require.NoError(t, err)
+
+	message = ProposalMessageFrom(voteMsg)
+	require.Equal(t, "/cosmos.gov.v1.MsgVote", message.Type)
+	require.Empty(t, message.Summary)
+}
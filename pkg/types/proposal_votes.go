@@ -0,0 +1,263 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govV1beta1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+type ProposalVoteOption struct {
+	Option string
+	Weight float64
+}
+
+// ProposalVote is how a voter has voted, split votes have multiple options.
+type ProposalVote struct {
+	Options []ProposalVoteOption
+}
+
+func ProposalVoteFromV1(vote *govV1Types.Vote) (*ProposalVote, error) {
+	options := make([]ProposalVoteOption, len(vote.Options))
+
+	for index, option := range vote.Options {
+		weight, err := strconv.ParseFloat(option.Weight, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		options[index] = ProposalVoteOption{Option: option.Option.String(), Weight: weight}
+	}
+
+	return &ProposalVote{Options: options}, nil
+}
+
+func ProposalVoteFromV1beta1(vote govV1beta1Types.Vote) *ProposalVote {
+	options := make([]ProposalVoteOption, len(vote.Options))
+
+	for index, option := range vote.Options {
+		options[index] = ProposalVoteOption{Option: option.Option.String(), Weight: option.Weight.MustFloat64()}
+	}
+
+	return &ProposalVote{Options: options}
+}
+
+func (v ProposalVote) Format() string {
+	if len(v.Options) == 1 {
+		return FormatVoteOption(v.Options[0].Option)
+	}
+
+	formatted := make([]string, len(v.Options))
+	for index, option := range v.Options {
+		formatted[index] = fmt.Sprintf("%s %.2f%%", FormatVoteOption(option.Option), option.Weight*100)
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+func (v ProposalVote) Equals(other *ProposalVote) bool {
+	if other == nil || len(v.Options) != len(other.Options) {
+		return false
+	}
+
+	for index, option := range v.Options {
+		if option != other.Options[index] {
+			return false
+		}
+	}
+
+	return true
+}
+
+type ValidatorVote struct {
+	Moniker         string
+	OperatorAddress string
+	// Validator voting power share, multiplied by the option weight if it's a split vote.
+	VotingPower float64
+	Weight      float64
+}
+
+func (v ValidatorVote) IsSplit() bool {
+	return v.Weight < 1
+}
+
+type ProposalVotesGroup struct {
+	Option     string
+	Validators []*ValidatorVote
+}
+
+func (g ProposalVotesGroup) VotingPower() float64 {
+	total := 0.0
+	for _, validator := range g.Validators {
+		total += validator.VotingPower
+	}
+
+	return total
+}
+
+func (g ProposalVotesGroup) FormatOption() string {
+	return FormatVoteOption(g.Option)
+}
+
+func FormatVoteOption(option string) string {
+	switch option {
+	case govV1Types.OptionYes.String():
+		return "✅Yes"
+	case govV1Types.OptionNo.String():
+		return "❌No"
+	case govV1Types.OptionNoWithVeto.String():
+		return "🚫No with veto"
+	case govV1Types.OptionAbstain.String():
+		return "🤷Abstain"
+	default:
+		return option
+	}
+}
+
+type ProposalVotes struct {
+	Chain     *Chain
+	Explorers Explorers
+	Proposal  *Proposal
+	Groups    []*ProposalVotesGroup
+	NotVoted  *ProposalVotesGroup
+	// Validators whose votes could not be fetched.
+	Unknown *ProposalVotesGroup
+	Error   error
+}
+
+// AddVote adds the validator to the groups of the options it has voted for.
+func (v *ProposalVotes) AddVote(validator *ValidatorVote, vote *ProposalVote) {
+	for _, option := range vote.Options {
+		group, found := findVotesGroup(v.Groups, option.Option)
+		if !found {
+			group = &ProposalVotesGroup{Option: option.Option, Validators: []*ValidatorVote{}}
+			v.Groups = append(v.Groups, group)
+		}
+
+		group.Validators = append(group.Validators, &ValidatorVote{
+			Moniker:         validator.Moniker,
+			OperatorAddress: validator.OperatorAddress,
+			VotingPower:     validator.VotingPower * option.Weight,
+			Weight:          option.Weight,
+		})
+	}
+}
+
+// SortGroups sorts groups as Yes, No, NoWithVeto, Abstain, and validators
+// in each group by voting power descending.
+func (v *ProposalVotes) SortGroups() {
+	order := map[string]int32{
+		govV1Types.OptionYes.String():        1,
+		govV1Types.OptionNo.String():         2,
+		govV1Types.OptionNoWithVeto.String(): 3,
+		govV1Types.OptionAbstain.String():    4,
+	}
+
+	sort.Slice(v.Groups, func(i, j int) bool {
+		return order[v.Groups[i].Option] < order[v.Groups[j].Option]
+	})
+
+	for _, group := range append(v.Groups, v.NotVoted, v.Unknown) {
+		sort.SliceStable(group.Validators, func(i, j int) bool {
+			return group.Validators[i].VotingPower > group.Validators[j].VotingPower
+		})
+	}
+}
+
+func findVotesGroup(groups []*ProposalVotesGroup, option string) (*ProposalVotesGroup, bool) {
+	for _, group := range groups {
+		if group.Option == option {
+			return group, true
+		}
+	}
+
+	return nil, false
+}
+
+// DelegatedValidatorVote is how a validator the wallet delegates to has voted,
+// Vote is nil if it hasn't voted.
+type DelegatedValidatorVote struct {
+	Validator *ValidatorAddressWithMoniker
+	Vote      *ProposalVote
+	Error     error
+}
+
+// WalletProposalVotes is how a linked wallet and its validators have voted on a proposal.
+// Vote is nil if the wallet hasn't voted itself, then its validators votes are counted instead.
+type WalletProposalVotes struct {
+	Wallet     *WalletLink
+	Vote       *ProposalVote
+	VoteError  error
+	Validators []*DelegatedValidatorVote
+	Error      error
+}
+
+func (v WalletProposalVotes) GetWalletName() string {
+	if !v.Wallet.Alias.IsZero() {
+		return v.Wallet.Alias.String
+	}
+
+	return v.Wallet.Address
+}
+
+// HasVoted returns whether the wallet has voted itself, overriding its validators votes.
+func (v WalletProposalVotes) HasVoted() bool {
+	return v.Vote != nil && v.VoteError == nil
+}
+
+// DisagreesWithValidators returns whether the wallet has voted differently
+// from any of its validators.
+func (v WalletProposalVotes) DisagreesWithValidators() bool {
+	if !v.HasVoted() {
+		return false
+	}
+
+	for _, validator := range v.Validators {
+		if validator.Error == nil && !v.Vote.Equals(validator.Vote) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasValidatorsNotVoted returns whether any of the wallet validators hasn't voted,
+// so the wallet stake with it is not counted unless the wallet votes itself.
+func (v WalletProposalVotes) HasValidatorsNotVoted() bool {
+	for _, validator := range v.Validators {
+		if validator.Error == nil && validator.Vote == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasSplitValidatorsVotes returns whether the wallet validators have voted differently
+// from each other, meaning at least one of them votes not the way the delegator would.
+func (v WalletProposalVotes) HasSplitValidatorsVotes() bool {
+	var first *ProposalVote
+
+	for _, validator := range v.Validators {
+		if validator.Error != nil || validator.Vote == nil {
+			continue
+		}
+
+		if first == nil {
+			first = validator.Vote
+		} else if !first.Equals(validator.Vote) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ShouldNudge returns whether the delegator should be reminded to vote itself,
+// as its validators either haven't voted or disagree with each other.
+func (v WalletProposalVotes) ShouldNudge() bool {
+	return !v.HasVoted() && (v.HasValidatorsNotVoted() || v.HasSplitValidatorsVotes())
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+
+	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalVoteFromV1Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ProposalVoteFromV1(&govV1Types.Vote{
+		Options: []*govV1Types.WeightedVoteOption{
+			{Option: govV1Types.OptionYes, Weight: "invalid"},
+		},
+	})
+	require.Error(t, err)
+}
+
+func TestProposalVotesAddVoteAndSort(t *testing.T) {
+	t.Parallel()
+
+	votes := ProposalVotes{
+		Groups:   []*ProposalVotesGroup{},
+		NotVoted: &ProposalVotesGroup{Validators: []*ValidatorVote{}},
+		Unknown:  &ProposalVotesGroup{Validators: []*ValidatorVote{}},
+	}
+
+	votes.AddVote(&ValidatorVote{Moniker: "first", VotingPower: 0.2}, &ProposalVote{
+		Options: []ProposalVoteOption{{Option: govV1Types.OptionAbstain.String(), Weight: 1}},
+	})
+	votes.AddVote(&ValidatorVote{Moniker: "second", VotingPower: 0.4}, &ProposalVote{
+		Options: []ProposalVoteOption{
+			{Option: govV1Types.OptionYes.String(), Weight: 0.25},
+			{Option: govV1Types.OptionAbstain.String(), Weight: 0.75},
+		},
+	})
+	votes.SortGroups()
+
+	require.Len(t, votes.Groups, 2)
+	require.Equal(t, "✅Yes", votes.Groups[0].FormatOption())
+	require.InDelta(t, 0.1, votes.Groups[0].VotingPower(), 0.0001)
+	require.True(t, votes.Groups[0].Validators[0].IsSplit())
+
+	require.Equal(t, "🤷Abstain", votes.Groups[1].FormatOption())
+	require.InDelta(t, 0.5, votes.Groups[1].VotingPower(), 0.0001)
+	require.Equal(t, "second", votes.Groups[1].Validators[0].Moniker)
+	require.False(t, votes.Groups[1].Validators[1].IsSplit())
+}
+
+func TestWalletProposalVotesNudge(t *testing.T) {
+	t.Parallel()
+
+	yes := &ProposalVote{Options: []ProposalVoteOption{{Option: govV1Types.OptionYes.String(), Weight: 1}}}
+	no := &ProposalVote{Options: []ProposalVoteOption{{Option: govV1Types.OptionNo.String(), Weight: 1}}}
+
+	agreeing := WalletProposalVotes{
+		Wallet:     &WalletLink{Address: "address"},
+		Validators: []*DelegatedValidatorVote{{Vote: yes}, {Vote: yes}},
+	}
+	require.False(t, agreeing.ShouldNudge())
+	require.Equal(t, "address", agreeing.GetWalletName())
+
+	split := WalletProposalVotes{Validators: []*DelegatedValidatorVote{{Vote: yes}, {Vote: no}}}
+	require.True(t, split.HasSplitValidatorsVotes())
+	require.True(t, split.ShouldNudge())
+
+	notVoted := WalletProposalVotes{Validators: []*DelegatedValidatorVote{{Vote: yes}, {}}}
+	require.True(t, notVoted.HasValidatorsNotVoted())
+	require.True(t, notVoted.ShouldNudge())
+
+	overridden := WalletProposalVotes{Vote: no, Validators: []*DelegatedValidatorVote{{Vote: yes}, {}}}
+	require.True(t, overridden.DisagreesWithValidators())
+	require.False(t, overridden.ShouldNudge())
+}
+
+func TestProposalVoteFormat(t *testing.T) {
+	t.Parallel()
+
+	vote := ProposalVote{Options: []ProposalVoteOption{
+		{Option: govV1Types.OptionYes.String(), Weight: 0.7},
+		{Option: govV1Types.OptionNo.String(), Weight: 0.3},
+	}}
+	require.Equal(t, "✅Yes 70.00%, ❌No 30.00%", vote.Format())
+}
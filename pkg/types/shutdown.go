@@ -0,0 +1,18 @@
+package types
+
+import (
+	"errors"
+	"time"
+)
+
+type ShutdownConfig struct {
+	GracePeriod time.Duration `default:"30s" toml:"grace-period"`
+}
+
+func (c *ShutdownConfig) Validate() error {
+	if c.GracePeriod <= 0 {
+		return errors.New("grace period should be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateShutdownConfigNoGracePeriod(t *testing.T) {
+	t.Parallel()
+
+	config := &ShutdownConfig{}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateShutdownConfigOk(t *testing.T) {
+	t.Parallel()
+
+	config := &ShutdownConfig{GracePeriod: 30 * time.Second}
+	err := config.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+
+	"github.com/guregu/null/v5"
+)
+
+var webhookSecretTokenRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{1,256}$`)
+
+// TelegramWebhookConfig configures receiving Telegram updates via a webhook
+// instead of long polling.
+type TelegramWebhookConfig struct {
+	Enabled     null.Bool `default:"false" toml:"enabled"`
+	ListenAddr  string    `toml:"listen-addr"`
+	PublicURL   string    `toml:"public-url"`
+	SecretToken string    `toml:"secret-token"`
+	// Certificate and key to serve the webhook over HTTPS,
+	// if TLS is not terminated by a reverse proxy.
+	TLSCert string `toml:"tls-cert"`
+	TLSKey  string `toml:"tls-key"`
+	// Self-signed certificate of the public URL to upload to Telegram.
+	PublicCert string `toml:"public-cert"`
+	// Whether to remove the webhook on shutdown. Off by default, as with several
+	// replicas, stopping one of them would stop the updates for all of them.
+	RemoveOnStop null.Bool `default:"false" toml:"remove-on-stop"`
+}
+
+func (c *TelegramWebhookConfig) Validate() error {
+	if !c.Enabled.Bool {
+		return nil
+	}
+
+	if c.ListenAddr == "" {
+		return errors.New("listen address is not set")
+	}
+
+	if c.PublicURL == "" {
+		return errors.New("public URL is not set")
+	}
+
+	publicURL, err := url.Parse(c.PublicURL)
+	if err != nil || publicURL.Scheme != "https" || publicURL.Host == "" {
+		return errors.New("public URL should be a valid https:// URL")
+	}
+
+	if c.SecretToken != "" && !webhookSecretTokenRegexp.MatchString(c.SecretToken) {
+		return errors.New("secret token should be 1-256 characters of A-Z, a-z, 0-9, _ and -")
+	}
+
+	if (c.TLSCert == "") != (c.TLSKey == "") {
+		return errors.New("TLS cert and key should be either both set or both not set")
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/guregu/null/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTelegramWebhookConfigDisabled(t *testing.T) {
+	t.Parallel()
+
+	config := &TelegramWebhookConfig{Enabled: null.BoolFrom(false)}
+	err := config.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
+
+func TestValidateTelegramWebhookConfigNoListenAddr(t *testing.T) {
+	t.Parallel()
+
+	config := &TelegramWebhookConfig{
+		Enabled:   null.BoolFrom(true),
+		PublicURL: "https://example.com/webhook",
+	}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateTelegramWebhookConfigNoPublicURL(t *testing.T) {
+	t.Parallel()
+
+	config := &TelegramWebhookConfig{
+		Enabled:    null.BoolFrom(true),
+		ListenAddr: ":8443",
+	}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateTelegramWebhookConfigPublicURLNotHTTPS(t *testing.T) {
+	t.Parallel()
+
+	config := &TelegramWebhookConfig{
+		Enabled:    null.BoolFrom(true),
+		ListenAddr: ":8443",
+		PublicURL:  "http://example.com/webhook",
+	}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateTelegramWebhookConfigInvalidSecretToken(t *testing.T) {
+	t.Parallel()
+
+	config := &TelegramWebhookConfig{
+		Enabled:     null.BoolFrom(true),
+		ListenAddr:  ":8443",
+		PublicURL:   "https://example.com/webhook",
+		SecretToken: "not a valid token!",
+	}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateTelegramWebhookConfigOnlyTLSCert(t *testing.T) {
+	t.Parallel()
+
+	config := &TelegramWebhookConfig{
+		Enabled:    null.BoolFrom(true),
+		ListenAddr: ":8443",
+		PublicURL:  "https://example.com/webhook",
+		TLSCert:    "cert.pem",
+	}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateTelegramWebhookConfigOk(t *testing.T) {
+	t.Parallel()
+
+	config := &TelegramWebhookConfig{
+		Enabled:     null.BoolFrom(true),
+		ListenAddr:  ":8443",
+		PublicURL:   "https://example.com/webhook",
+		SecretToken: "secret_token-123",
+		TLSCert:     "cert.pem",
+		TLSKey:      "key.pem",
+	}
+	err := config.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
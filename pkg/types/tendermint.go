@@ -1,6 +1,102 @@
 package types
 
+import (
+	"encoding/json"
+	"time"
+)
+
 type LCDError struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
 }
+
+func (e LCDError) Error() string {
+	return e.Message
+}
+
+// TxsResponse is a response from /cosmos/tx/v1beta1/txs. It's decoded manually
+// and not via the codec, as txs can contain messages of any type, including
+// the ones we have no protobuf types for, and the codec fails on these.
+type TxsResponse struct {
+	TxResponses []TxResponse `json:"tx_responses"`
+	Total       uint64       `json:"total,string"`
+}
+
+type TxResponse struct {
+	Height    string    `json:"height"`
+	TxHash    string    `json:"txhash"`
+	Code      uint32    `json:"code"`
+	Timestamp time.Time `json:"timestamp"`
+	Tx        struct {
+		Body struct {
+			Messages []json.RawMessage `json:"messages"`
+			Memo     string            `json:"memo"`
+		} `json:"body"`
+	} `json:"tx"`
+}
+
+// RawProposalsResponse is a response from /cosmos/gov/v1/proposals or its v1beta1 version,
+// decoded manually for the same reason as txs: proposals can contain messages of types
+// we have no protobuf types for, like IBC or wasm ones, and a single one of these
+// would fail decoding the whole page.
+type RawProposalsResponse struct {
+	Proposals  []json.RawMessage `json:"proposals"`
+	Pagination *struct {
+		NextKey []byte `json:"next_key"`
+	} `json:"pagination"`
+}
+
+func (r *RawProposalsResponse) GetNextKey() []byte {
+	if r.Pagination == nil {
+		return nil
+	}
+
+	return r.Pagination.NextKey
+}
+
+// RawAccountResponse is a response from /cosmos/auth/v1beta1/accounts/{address},
+// used to get the account type if it's not registered in the codec,
+// like accounts of chains having their own account types, like ethermint ones.
+type RawAccountResponse struct {
+	Account struct {
+		Type string `json:"@type"`
+	} `json:"account"`
+}
+
+type RawTxMessage struct {
+	Type string `json:"@type"`
+}
+
+type RawAuthzExecMessage struct {
+	Grantee  string            `json:"grantee"`
+	Messages []json.RawMessage `json:"msgs"`
+}
+
+type RawIBCRecvPacketMessage struct {
+	Packet struct {
+		SourcePort         string `json:"source_port"`
+		SourceChannel      string `json:"source_channel"`
+		DestinationPort    string `json:"destination_port"`
+		DestinationChannel string `json:"destination_channel"`
+		Data               []byte `json:"data"`
+	} `json:"packet"`
+}
+
+// RawFungibleTokenPacketData is the ICS-20 packet data, denom is the one
+// on the sending chain, with the denom trace if any.
+type RawFungibleTokenPacketData struct {
+	Denom    string `json:"denom"`
+	Amount   string `json:"amount"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+}
+
+type RawIBCTransferMessage struct {
+	SourceChannel string `json:"source_channel"`
+	Sender        string `json:"sender"`
+	Receiver      string `json:"receiver"`
+	Token         struct {
+		Denom  string `json:"denom"`
+		Amount string `json:"amount"`
+	} `json:"token"`
+}
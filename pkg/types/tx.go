@@ -0,0 +1,151 @@
+package types
+
+import (
+	"strings"
+	"time"
+)
+
+type TxMessageType string
+
+const (
+	TxMessageTypeSend            TxMessageType = "send"
+	TxMessageTypeDelegate        TxMessageType = "delegate"
+	TxMessageTypeUndelegate      TxMessageType = "undelegate"
+	TxMessageTypeRedelegate      TxMessageType = "redelegate"
+	TxMessageTypeClaimRewards    TxMessageType = "claim_rewards"
+	TxMessageTypeClaimCommission TxMessageType = "claim_commission"
+	TxMessageTypeVote            TxMessageType = "vote"
+	TxMessageTypeIBCTransfer     TxMessageType = "ibc_transfer"
+	TxMessageTypeExec            TxMessageType = "exec"
+	TxMessageTypeUnsupported     TxMessageType = "unsupported"
+)
+
+type Tx struct {
+	Hash      string
+	Height    string
+	Timestamp time.Time
+	Success   bool
+	Memo      string
+	Messages  []*TxMessage
+}
+
+type TxMessage struct {
+	Type TxMessageType
+	// Full type URL, for displaying messages we cannot parse.
+	TypeURL      string
+	From         string
+	To           string
+	Validator    string
+	DstValidator string
+	Amounts      []*Amount
+	ProposalID   uint64
+	Option       string
+	Channel      string
+	// Whether the wallet we display txs for received the tokens.
+	Incoming bool
+	// Messages executed via authz exec.
+	Messages []*TxMessage
+}
+
+// WalletTxNotification is a tx involving a linked wallet, sent to the wallet owner
+// as soon as it's included in a block.
+type WalletTxNotification struct {
+	Chain     *Chain
+	Explorers Explorers
+	Wallet    *WalletLink
+	Tx        *Tx
+}
+
+type WalletsTxs struct {
+	Error   error
+	Wallets []*WalletTxs
+}
+
+type WalletTxs struct {
+	Chain     *Chain
+	Explorers Explorers
+	Wallet    *WalletLink
+	Txs       []*Tx
+	Error     error
+}
+
+// GetAllAmounts returns amounts of this message and the ones it executes,
+// so they can all be converted to display denoms.
+func (m *TxMessage) GetAllAmounts() []*Amount {
+	amounts := append([]*Amount{}, m.Amounts...)
+
+	for _, message := range m.Messages {
+		amounts = append(amounts, message.GetAllAmounts()...)
+	}
+
+	return amounts
+}
+
+func (m *TxMessage) FormatVoteOption() string {
+	option := strings.TrimPrefix(m.Option, "VOTE_OPTION_")
+	return strings.ReplaceAll(strings.ToLower(option), "_", " ")
+}
+
+// InvolvesWallet returns whether the message moves tokens from or to the wallet,
+// or changes its delegations. For authz exec, it's true if any of the messages
+// it executes does.
+func (m *TxMessage) InvolvesWallet(address string) bool {
+	switch m.Type {
+	case TxMessageTypeSend, TxMessageTypeIBCTransfer:
+		return m.From == address || m.To == address
+	case TxMessageTypeDelegate, TxMessageTypeUndelegate, TxMessageTypeRedelegate:
+		return m.From == address
+	case TxMessageTypeExec:
+		for _, message := range m.Messages {
+			if message.InvolvesWallet(address) {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return false
+	}
+}
+
+// HasAmountAbove returns whether at least one of the message amounts (or the amounts
+// of the messages it executes) is not less than the threshold.
+// Should be called after the denoms are populated, so amounts are in display denom.
+func (m *TxMessage) HasAmountAbove(threshold float64) bool {
+	for _, amount := range m.GetAllAmounts() {
+		if amount.Amount.MustFloat64() >= threshold {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FilterWalletMessages returns the messages involving the wallet with amounts
+// not less than the threshold. Authz exec messages are copied, keeping only
+// the executed messages that match.
+func FilterWalletMessages(messages []*TxMessage, address string, threshold float64) []*TxMessage {
+	filtered := make([]*TxMessage, 0)
+
+	for _, message := range messages {
+		if !message.InvolvesWallet(address) {
+			continue
+		}
+
+		if message.Type == TxMessageTypeExec {
+			execMessage := *message
+			execMessage.Messages = FilterWalletMessages(message.Messages, address, threshold)
+			if len(execMessage.Messages) > 0 {
+				filtered = append(filtered, &execMessage)
+			}
+
+			continue
+		}
+
+		if message.HasAmountAbove(threshold) {
+			filtered = append(filtered, message)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxMessageInvolvesWallet(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, (&TxMessage{Type: TxMessageTypeSend, From: "a", To: "b"}).InvolvesWallet("b"))
+	require.True(t, (&TxMessage{Type: TxMessageTypeDelegate, From: "a"}).InvolvesWallet("a"))
+	require.False(t, (&TxMessage{Type: TxMessageTypeVote, From: "a"}).InvolvesWallet("a"))
+	require.False(t, (&TxMessage{Type: TxMessageTypeSend, From: "a", To: "b"}).InvolvesWallet("c"))
+	require.True(t, (&TxMessage{
+		Type:     TxMessageTypeExec,
+		From:     "c",
+		Messages: []*TxMessage{{Type: TxMessageTypeDelegate, From: "a"}},
+	}).InvolvesWallet("a"))
+}
+
+func TestFilterWalletMessages(t *testing.T) {
+	t.Parallel()
+
+	messages := []*TxMessage{
+		{
+			Type:    TxMessageTypeSend,
+			From:    "a",
+			To:      "b",
+			Amounts: []*Amount{{Amount: math.LegacyNewDec(1), Denom: "ATOM"}},
+		},
+		{
+			Type:    TxMessageTypeSend,
+			From:    "b",
+			To:      "a",
+			Amounts: []*Amount{{Amount: math.LegacyNewDec(5), Denom: "ATOM"}},
+		},
+		{
+			Type: TxMessageTypeExec,
+			From: "c",
+			Messages: []*TxMessage{
+				{Type: TxMessageTypeClaimRewards, From: "a", Validator: "v"},
+				{Type: TxMessageTypeDelegate, From: "a", Amounts: []*Amount{{Amount: math.LegacyNewDec(3), Denom: "ATOM"}}},
+			},
+		},
+	}
+
+	filtered := FilterWalletMessages(messages, "a", 2)
+	require.Len(t, filtered, 2)
+	require.Equal(t, messages[1], filtered[0])
+	require.Equal(t, TxMessageTypeExec, filtered[1].Type)
+	require.Len(t, filtered[1].Messages, 1)
+	require.Equal(t, TxMessageTypeDelegate, filtered[1].Messages[0].Type)
+
+	// original exec message is not modified
+	require.Len(t, messages[2].Messages, 2)
+}
@@ -8,6 +8,7 @@ import (
 	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"main/pkg/constants"
+	"main/pkg/utils"
 	"time"
 
 	govV1beta1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -39,6 +40,42 @@ func (a *Amount) IsIgnored() bool {
 	return a.DenomInfo == nil || a.DenomInfo.Ignored
 }
 
+// Mul returns a new Amount multiplied by the given value, with its USD price
+// multiplied as well, if present.
+func (a *Amount) Mul(multiplier math.LegacyDec) *Amount {
+	amount := &Amount{
+		Amount:    a.Amount.Mul(multiplier),
+		Denom:     a.Denom,
+		BaseDenom: a.BaseDenom,
+		DenomInfo: a.DenomInfo,
+	}
+
+	if a.PriceUSD != nil {
+		priceUSD := a.PriceUSD.Mul(multiplier)
+		amount.PriceUSD = &priceUSD
+	}
+
+	return amount
+}
+
+// Add returns a new Amount which is a sum of both amounts, which should be of the same denom.
+// The USD price is only kept if both amounts have it.
+func (a *Amount) Add(other *Amount) *Amount {
+	amount := &Amount{
+		Amount:    a.Amount.Add(other.Amount),
+		Denom:     a.Denom,
+		BaseDenom: a.BaseDenom,
+		DenomInfo: a.DenomInfo,
+	}
+
+	if a.PriceUSD != nil && other.PriceUSD != nil {
+		priceUSD := a.PriceUSD.Add(*other.PriceUSD)
+		amount.PriceUSD = &priceUSD
+	}
+
+	return amount
+}
+
 func AmountFrom(coin cosmosTypes.Coin) *Amount {
 	return &Amount{
 		Amount: coin.Amount.ToLegacyDec(),
@@ -60,20 +97,44 @@ type Proposal struct {
 	VotingEndTime   time.Time `json:"voting_end_time"`
 	Title           string    `json:"title"`
 	Summary         string    `json:"summary"`
+
+	TotalDeposit []*Amount          `json:"total_deposit"`
+	Messages     []*ProposalMessage `json:"messages"`
 }
 
 func ProposalFromV1(p *govV1Types.Proposal) *Proposal {
+	messages := make([]*ProposalMessage, len(p.Messages))
+	for index, message := range p.Messages {
+		messages[index] = ProposalMessageFrom(message)
+	}
+
+	// Proposals in the deposit period have no voting times yet.
+	var votingStartTime, votingEndTime time.Time
+	if p.VotingStartTime != nil {
+		votingStartTime = *p.VotingStartTime
+	}
+	if p.VotingEndTime != nil {
+		votingEndTime = *p.VotingEndTime
+	}
+
 	return &Proposal{
 		ID:              fmt.Sprintf("%d", p.Id),
 		Status:          p.Status.String(),
-		VotingStartTime: *p.VotingStartTime,
-		VotingEndTime:   *p.VotingEndTime,
+		VotingStartTime: votingStartTime,
+		VotingEndTime:   votingEndTime,
 		Title:           p.Title,
 		Summary:         p.Summary,
+		TotalDeposit:    utils.Map(p.TotalDeposit, AmountFrom),
+		Messages:        messages,
 	}
 }
 
 func ProposalFromV1beta1(p govV1beta1Types.Proposal) *Proposal {
+	messages := []*ProposalMessage{}
+	if p.Content != nil {
+		messages = append(messages, ProposalMessageFrom(p.Content))
+	}
+
 	return &Proposal{
 		ID:              fmt.Sprintf("%d", p.ProposalId),
 		Status:          p.Status.String(),
@@ -81,17 +142,23 @@ func ProposalFromV1beta1(p govV1beta1Types.Proposal) *Proposal {
 		VotingEndTime:   p.VotingEndTime,
 		Title:           p.GetTitle(),
 		Summary:         p.GetContent().GetDescription(),
+		TotalDeposit:    utils.Map(p.TotalDeposit, AmountFrom),
+		Messages:        messages,
 	}
 }
 
 func (p Proposal) FormatStatus() string {
 	switch p.Status {
+	case "PROPOSAL_STATUS_DEPOSIT_PERIOD":
+		return "💰In deposit"
 	case "PROPOSAL_STATUS_VOTING_PERIOD":
 		return "📥In voting"
 	case "PROPOSAL_STATUS_PASSED":
 		return "🏁Passed"
 	case "PROPOSAL_STATUS_REJECTED":
 		return "☠️Rejected"
+	case "PROPOSAL_STATUS_FAILED":
+		return "💥Failed"
 	default:
 		return p.Status
 	}
@@ -124,6 +191,24 @@ type ChainParams struct {
 
 	Inflation      math.LegacyDec
 	InflationError error
+
+	Decentralization      *DecentralizationStats
+	DecentralizationError error
+}
+
+type ChainsAPR struct {
+	Error error
+	APRs  map[string]*ChainAPR
+}
+
+type ChainAPR struct {
+	Chain *Chain
+	APR   float64
+	Error error
+}
+
+func (a ChainAPR) APY() float64 {
+	return utils.APRToAPY(a.APR, constants.CompoundPeriodsPerYear)
 }
 
 type ActiveProposals struct {
@@ -143,6 +228,49 @@ type SingleProposal struct {
 	Explorers Explorers
 	Proposal  *Proposal
 	Error     error
+
+	Tally            *ProposalTally
+	TallyError       error
+	BondedTokens     math.Int
+	PoolError        error
+	TallyParams      govV1beta1Types.TallyParams
+	TallyParamsError error
+
+	// Votes of the user's linked wallets on this chain and their validators.
+	WalletsVotes      []*WalletProposalVotes
+	WalletsVotesError error
+}
+
+func (p SingleProposal) IsInVoting() bool {
+	return p.Proposal != nil && p.Proposal.Status == govV1Types.StatusVotingPeriod.String()
+}
+
+func (p SingleProposal) HasTallyInfo() bool {
+	return p.Tally != nil && p.TallyError == nil && p.PoolError == nil && p.TallyParamsError == nil
+}
+
+// Turnout is the share of bonded tokens that have voted.
+func (p SingleProposal) Turnout() float64 {
+	if p.BondedTokens.IsNil() || p.BondedTokens.IsZero() {
+		return 0
+	}
+
+	return p.Tally.Total().ToLegacyDec().Quo(p.BondedTokens.ToLegacyDec()).MustFloat64()
+}
+
+func (p SingleProposal) QuorumReached() bool {
+	return p.Turnout() >= p.TallyParams.Quorum.MustFloat64()
+}
+
+// ThresholdReached is whether the share of Yes votes, not counting Abstain ones,
+// is above the threshold needed for the proposal to pass.
+func (p SingleProposal) ThresholdReached() bool {
+	return p.Tally.YesWithoutAbstainRatio() > p.TallyParams.Threshold.MustFloat64()
+}
+
+// VetoReached is whether the share of NoWithVeto votes is enough to veto the proposal.
+func (p SingleProposal) VetoReached() bool {
+	return p.Tally.NoWithVetoRatio() > p.TallyParams.VetoThreshold.MustFloat64()
 }
 
 type ValidatorsInfo struct {
@@ -156,6 +284,18 @@ type ChainValidatorsInfo struct {
 	Error          error
 	Validators     []ValidatorInfo
 	SlashingParams *slashingTypes.Params
+	APR            float64
+	APRError       error
+}
+
+// GetValidatorAPR returns the APR delegators get when staking with this validator,
+// which is chain APR minus the validator's commission.
+func (i ChainValidatorsInfo) GetValidatorAPR(validator ValidatorInfo) float64 {
+	return i.APR * (1 - validator.Commission)
+}
+
+func (i ChainValidatorsInfo) GetValidatorAPY(validator ValidatorInfo) float64 {
+	return utils.APRToAPY(i.GetValidatorAPR(validator), constants.CompoundPeriodsPerYear)
 }
 
 func (i ChainValidatorsInfo) FormatValidatorUptime(validator ValidatorInfo) string {
@@ -202,6 +342,8 @@ type ValidatorInfo struct {
 	Rank                    int
 
 	SigningInfo *slashingTypes.ValidatorSigningInfo
+	// Only fetched for validators comparison, nil if unknown.
+	SelfDelegation *Amount
 }
 
 func (i ValidatorInfo) Active() bool {
@@ -217,9 +359,10 @@ func (i ValidatorInfo) GetVotingPowerPercent() string {
 }
 
 type ValidatorAddressWithMoniker struct {
-	Chain   *Chain
-	Address string
-	Moniker string
+	Chain      *Chain
+	Address    string
+	Moniker    string
+	Commission *math.LegacyDec
 }
 
 func (v *ValidatorAddressWithMoniker) GetName() string {
@@ -231,8 +374,39 @@ func (v *ValidatorAddressWithMoniker) GetName() string {
 }
 
 type Delegation struct {
-	Amount    *Amount
-	Validator *ValidatorAddressWithMoniker
+	Amount     *Amount
+	Validator  *ValidatorAddressWithMoniker
+	Projection *RewardsProjection
+}
+
+type RewardsProjection struct {
+	Daily   *Amount
+	Monthly *Amount
+	Yearly  *Amount
+}
+
+// NewRewardsProjection estimates the rewards for the staked amount given the yearly
+// rewards rate (which is chain APR minus validator commission), without compounding.
+func NewRewardsProjection(staked *Amount, yearlyRate math.LegacyDec) *RewardsProjection {
+	yearly := staked.Mul(yearlyRate)
+
+	return &RewardsProjection{
+		Daily:   yearly.Mul(math.LegacyOneDec().QuoInt64(365)),
+		Monthly: yearly.Mul(math.LegacyOneDec().QuoInt64(12)),
+		Yearly:  yearly,
+	}
+}
+
+func (p *RewardsProjection) Add(other *RewardsProjection) *RewardsProjection {
+	if p == nil {
+		return other
+	}
+
+	return &RewardsProjection{
+		Daily:   p.Daily.Add(other.Daily),
+		Monthly: p.Monthly.Add(other.Monthly),
+		Yearly:  p.Yearly.Add(other.Yearly),
+	}
 }
 
 type Redelegation struct {
@@ -257,6 +431,8 @@ type ChainWalletsBalancesInfo struct {
 	Chain        *Chain
 	Explorers    Explorers
 	BalancesInfo map[string]*WalletBalancesInfo
+	APR          float64
+	APRError     error
 }
 
 type WalletBalancesInfo struct {
@@ -273,6 +449,7 @@ type WalletBalancesInfo struct {
 	RedelegationsError error
 	Unbonds            []*Unbond
 	UnbondsError       error
+	Projection         *RewardsProjection
 }
 
 func (w *WalletsBalancesInfo) SetChain(chain *Chain, explorers []*Explorer) {
@@ -283,6 +460,14 @@ func (w *WalletsBalancesInfo) SetChain(chain *Chain, explorers []*Explorer) {
 	}
 }
 
+func (w *WalletsBalancesInfo) SetAPR(chainName string, apr float64) {
+	w.Infos[chainName].APR = apr
+}
+
+func (w *WalletsBalancesInfo) SetAPRError(chainName string, err error) {
+	w.Infos[chainName].APRError = err
+}
+
 func (w *WalletsBalancesInfo) SetAddressInfo(chainName string, address *WalletLink) {
 	if _, ok := w.Infos[chainName].BalancesInfo[address.Address]; !ok {
 		w.Infos[chainName].BalancesInfo[address.Address] = &WalletBalancesInfo{
@@ -339,6 +524,26 @@ func (w *WalletsBalancesInfo) SetUnbonds(chainName string, address *WalletLink,
 	w.Infos[chainName].BalancesInfo[address.Address].Unbonds = unbonds
 }
 
+type CompoundInfo struct {
+	Chain                 *Chain
+	Address               string
+	Error                 error
+	TotalStaked           *Amount
+	APR                   float64
+	APY                   float64
+	RestakeFee            *Amount
+	RestakesPerYear       int
+	RewardsWithRestake    *Amount
+	RewardsWithoutRestake *Amount
+	// Set if the node does not report the minimum gas price,
+	// so the restake fee is calculated with this default one.
+	DefaultGasPrice string
+}
+
+func (c CompoundInfo) RestakeInterval() time.Duration {
+	return (365 * 24 * time.Hour / time.Duration(c.RestakesPerYear)).Round(time.Hour)
+}
+
 type SupplyInfo struct {
 	Error    error
 	Supplies map[string]*ChainSupply
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	upgradeTypes "cosmossdk.io/x/upgrade/types"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/guregu/null/v5"
+)
+
+type UpgradesConfig struct {
+	Enabled  null.Bool     `default:"true" toml:"enabled"`
+	Interval time.Duration `default:"1m"   toml:"interval"`
+}
+
+func (c *UpgradesConfig) Validate() error {
+	if c.Interval <= 0 {
+		return errors.New("interval should be positive")
+	}
+
+	return nil
+}
+
+type UpgradePlan struct {
+	Name   string
+	Height int64
+	Info   string
+	// Empty if the plan is the current one and not taken from a proposal.
+	ProposalID string
+}
+
+func UpgradePlanFrom(plan upgradeTypes.Plan, proposalID string) *UpgradePlan {
+	return &UpgradePlan{
+		Name:       plan.Name,
+		Height:     plan.Height,
+		Info:       plan.Info,
+		ProposalID: proposalID,
+	}
+}
+
+// RawUpgradeProposal is a v1 or v1beta1 proposal with only the fields needed
+// to find its upgrade plans.
+type RawUpgradeProposal struct {
+	ID         string            `json:"id"`
+	ProposalID string            `json:"proposal_id"`
+	Messages   []json.RawMessage `json:"messages"`
+	Content    json.RawMessage   `json:"content"`
+}
+
+// RawUpgradeMessage is a proposal message or content, being a MsgSoftwareUpgrade,
+// a SoftwareUpgradeProposal, or a MsgExecLegacyContent wrapping the latter.
+type RawUpgradeMessage struct {
+	Type    string          `json:"@type"`
+	Plan    json.RawMessage `json:"plan"`
+	Content json.RawMessage `json:"content"`
+}
+
+func (p RawUpgradeProposal) GetID() string {
+	if p.ID != "" {
+		return p.ID
+	}
+
+	return p.ProposalID
+}
+
+// GetRawPlans returns the upgrade plans the proposal contains, either as
+// MsgSoftwareUpgrade or as a legacy SoftwareUpgradeProposal content, without decoding
+// the other messages, so the ones of unknown types are skipped.
+func (p RawUpgradeProposal) GetRawPlans() []json.RawMessage {
+	messages := p.Messages
+	if len(p.Content) > 0 {
+		messages = append(messages, p.Content)
+	}
+
+	plans := make([]json.RawMessage, 0)
+	for _, message := range messages {
+		plans = append(plans, GetRawUpgradePlans(message)...)
+	}
+
+	return plans
+}
+
+func GetRawUpgradePlans(message json.RawMessage) []json.RawMessage {
+	var upgradeMessage RawUpgradeMessage
+	if err := json.Unmarshal(message, &upgradeMessage); err != nil {
+		return []json.RawMessage{}
+	}
+
+	switch upgradeMessage.Type {
+	case codecTypes.MsgTypeURL(&upgradeTypes.MsgSoftwareUpgrade{}),
+		codecTypes.MsgTypeURL(&upgradeTypes.SoftwareUpgradeProposal{}): //nolint:staticcheck
+		return []json.RawMessage{upgradeMessage.Plan}
+	case codecTypes.MsgTypeURL(&govV1Types.MsgExecLegacyContent{}):
+		return GetRawUpgradePlans(upgradeMessage.Content)
+	default:
+		return []json.RawMessage{}
+	}
+}
+
+type ChainUpgrade struct {
+	Plan          *UpgradePlan
+	EstimatedTime time.Time
+}
+
+type ChainUpgrades struct {
+	Chain        *Chain
+	Explorers    Explorers
+	LatestHeight int64
+	Upgrades     []*ChainUpgrade
+	Error        error
+}
+
+type UpgradesInfo struct {
+	Error  error
+	Chains map[string]*ChainUpgrades
+}
+
+type UpgradeNotification struct {
+	Chain     *Chain
+	Explorers Explorers
+	Upgrade   *ChainUpgrade
+	TimeLeft  time.Duration
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateUpgradesConfigNoInterval(t *testing.T) {
+	t.Parallel()
+
+	config := &UpgradesConfig{}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateUpgradesConfigOk(t *testing.T) {
+	t.Parallel()
+
+	config := &UpgradesConfig{Interval: time.Minute}
+	err := config.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
+
+func TestRawUpgradeProposalV1(t *testing.T) {
+	t.Parallel()
+
+	var proposal RawUpgradeProposal
+	err := json.Unmarshal([]byte(`{
+		"id": "5",
+		"messages": [
+			{"@type": "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", "plan": {"name": "v22", "height": "100"}},
+			{
+				"@type": "/cosmos.gov.v1.MsgExecLegacyContent",
+				"content": {
+					"@type": "/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal",
+					"plan": {"name": "v23", "height": "200", "info": "info"}
+				}
+			},
+			{"@type": "/ibc.core.client.v1.MsgRecoverClient", "subject_client_id": "07-tendermint-1"},
+			{"@type": "/cosmwasm.wasm.v1.MsgMigrateContract", "msg": {"content": "not a proposal content"}}
+		]
+	}`), &proposal)
+	require.NoError(t, err)
+
+	require.Equal(t, "5", proposal.GetID())
+	require.Equal(t, []json.RawMessage{
+		json.RawMessage(`{"name": "v22", "height": "100"}`),
+		json.RawMessage(`{"name": "v23", "height": "200", "info": "info"}`),
+	}, proposal.GetRawPlans())
+}
+
+func TestRawUpgradeProposalV1beta1(t *testing.T) {
+	t.Parallel()
+
+	var proposal RawUpgradeProposal
+	err := json.Unmarshal([]byte(`{
+		"proposal_id": "5",
+		"content": {"@type": "/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal", "plan": {"name": "v22"}}
+	}`), &proposal)
+	require.NoError(t, err)
+
+	require.Equal(t, "5", proposal.GetID())
+	require.Equal(t, []json.RawMessage{json.RawMessage(`{"name": "v22"}`)}, proposal.GetRawPlans())
+
+	err = json.Unmarshal([]byte(`{
+		"proposal_id": "6",
+		"content": {"@type": "/cosmos.gov.v1beta1.TextProposal", "title": "text"}
+	}`), &proposal)
+	require.NoError(t, err)
+	require.Empty(t, proposal.GetRawPlans())
+}
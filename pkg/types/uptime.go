@@ -0,0 +1,166 @@
+package types
+
+import (
+	"errors"
+	"main/pkg/constants"
+	"math"
+	"strings"
+	"time"
+
+	slashingTypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/guregu/null/v5"
+)
+
+type UptimeConfig struct {
+	Enabled      null.Bool `default:"true" toml:"enabled"`
+	BlocksWindow int       `default:"1000" toml:"blocks-window"`
+}
+
+func (c *UptimeConfig) Validate() error {
+	if c.BlocksWindow <= 0 {
+		return errors.New("blocks-window should be positive")
+	}
+
+	return nil
+}
+
+// BlockSignatures is whether each validator of the active set signed a block,
+// keyed by the validator hex consensus address.
+type BlockSignatures struct {
+	Height     int64
+	Time       time.Time
+	Signatures map[string]bool
+}
+
+type ValidatorBlock struct {
+	Height int64
+	Time   time.Time
+	Signed bool
+}
+
+type ValidatorUptime struct {
+	Chain          *Chain
+	Explorers      Explorers
+	Validator      *ValidatorInfo
+	Blocks         []ValidatorBlock
+	SlashingParams *slashingTypes.Params
+	Error          error
+}
+
+func (u ValidatorUptime) MissedBlocksCount() int {
+	missed := 0
+	for _, block := range u.Blocks {
+		if !block.Signed {
+			missed++
+		}
+	}
+
+	return missed
+}
+
+func (u ValidatorUptime) Uptime() float64 {
+	if len(u.Blocks) == 0 {
+		return 0
+	}
+
+	return 1 - float64(u.MissedBlocksCount())/float64(len(u.Blocks))
+}
+
+// HeatStrip shows the tracked blocks as a strip of cells, each cell covering
+// several blocks and colored by how many of them were missed.
+func (u ValidatorUptime) HeatStrip() string {
+	cellsCount := min(constants.UptimeHeatStripCells, len(u.Blocks))
+
+	var sb strings.Builder
+
+	for cell := range cellsCount {
+		from := cell * len(u.Blocks) / cellsCount
+		to := (cell + 1) * len(u.Blocks) / cellsCount
+
+		missed := 0
+		for _, block := range u.Blocks[from:to] {
+			if !block.Signed {
+				missed++
+			}
+		}
+
+		switch {
+		case missed == 0:
+			sb.WriteString("🟩")
+		case float64(missed)/float64(to-from) < 0.5:
+			sb.WriteString("🟨")
+		default:
+			sb.WriteString("🟥")
+		}
+	}
+
+	return sb.String()
+}
+
+func (u ValidatorUptime) LongestMissedStreak() int {
+	longest, current := 0, 0
+
+	for _, block := range u.Blocks {
+		if block.Signed {
+			current = 0
+			continue
+		}
+
+		current++
+		longest = max(longest, current)
+	}
+
+	return longest
+}
+
+func (u ValidatorUptime) BlockTime() time.Duration {
+	if len(u.Blocks) < 2 {
+		return 0
+	}
+
+	first, last := u.Blocks[0], u.Blocks[len(u.Blocks)-1]
+	return last.Time.Sub(first.Time) / time.Duration(last.Height-first.Height)
+}
+
+func (u ValidatorUptime) WillBeJailed() bool {
+	_, willBeJailed := u.estimateTimeToJail()
+	return willBeJailed
+}
+
+func (u ValidatorUptime) TimeToJail() time.Duration {
+	timeToJail, _ := u.estimateTimeToJail()
+	return timeToJail
+}
+
+// estimateTimeToJail estimates when the validator would be jailed if it keeps missing
+// blocks at the same rate as over the tracked blocks. New misses increase the missed
+// blocks counter and old ones leave the signed blocks window, so at a miss rate r
+// the counter approaches r * window as c(t) = r*w + (c0 - r*w) * e^(-t/w),
+// and the validator is only jailed if r * window is above the max missed blocks.
+// Returns false if the validator is not going to be jailed at this rate.
+func (u ValidatorUptime) estimateTimeToJail() (time.Duration, bool) {
+	if u.SlashingParams == nil || len(u.Blocks) == 0 || u.SlashingParams.SignedBlocksWindow <= 0 {
+		return 0, false
+	}
+
+	window := float64(u.SlashingParams.SignedBlocksWindow)
+	minSigned := u.SlashingParams.MinSignedPerWindow.MulInt64(u.SlashingParams.SignedBlocksWindow).RoundInt64()
+	jailedAt := window - float64(minSigned) + 1
+
+	missedCounter := float64(u.MissedBlocksCount())
+	if u.Validator != nil && u.Validator.SigningInfo != nil {
+		missedCounter = float64(u.Validator.SigningInfo.MissedBlocksCounter)
+	}
+
+	if missedCounter >= jailedAt {
+		return 0, true
+	}
+
+	steadyMissed := (1 - u.Uptime()) * window
+	if steadyMissed <= jailedAt {
+		return 0, false
+	}
+
+	blocksToJail := math.Ceil(-window * math.Log((jailedAt-steadyMissed)/(missedCounter-steadyMissed)))
+	return time.Duration(blocksToJail) * u.BlockTime(), true
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	slashingTypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func getValidatorBlocks(missed func(height int64) bool) []ValidatorBlock {
+	startTime := time.Date(2025, 1, 17, 12, 0, 0, 0, time.UTC)
+	blocks := make([]ValidatorBlock, 0)
+
+	for height := int64(1); height <= 100; height++ {
+		blocks = append(blocks, ValidatorBlock{
+			Height: height,
+			Time:   startTime.Add(time.Duration(height) * 6 * time.Second),
+			Signed: !missed(height),
+		})
+	}
+
+	return blocks
+}
+
+func TestValidateUptimeConfigInvalid(t *testing.T) {
+	t.Parallel()
+
+	config := &UptimeConfig{}
+	require.Error(t, config.Validate())
+}
+
+func TestValidateUptimeConfigOk(t *testing.T) {
+	t.Parallel()
+
+	config := &UptimeConfig{BlocksWindow: 1000}
+	require.NoError(t, config.Validate())
+}
+
+func TestValidatorUptimeNoBlocks(t *testing.T) {
+	t.Parallel()
+
+	uptime := ValidatorUptime{}
+	require.Empty(t, uptime.HeatStrip())
+	require.Zero(t, uptime.LongestMissedStreak())
+	require.Zero(t, uptime.BlockTime())
+	require.False(t, uptime.WillBeJailed())
+}
+
+func TestValidatorUptimeStats(t *testing.T) {
+	t.Parallel()
+
+	uptime := ValidatorUptime{
+		Blocks: getValidatorBlocks(func(height int64) bool {
+			return height == 3 || (height > 90 && height <= 95)
+		}),
+	}
+
+	require.Equal(t, 6, uptime.MissedBlocksCount())
+	require.InDelta(t, 0.94, uptime.Uptime(), 0.0001)
+	require.Equal(t, 5, uptime.LongestMissedStreak())
+	require.Equal(t, 6*time.Second, uptime.BlockTime())
+	require.Equal(t, "🟨🟩🟩🟩🟩🟩🟩🟩🟩🟩🟩🟩🟩🟩🟩🟩🟩🟩🟥🟩", uptime.HeatStrip())
+}
+
+func TestValidatorUptimeNotAtRisk(t *testing.T) {
+	t.Parallel()
+
+	uptime := ValidatorUptime{
+		Blocks: getValidatorBlocks(func(height int64) bool { return height%10 == 0 }),
+		SlashingParams: &slashingTypes.Params{
+			SignedBlocksWindow: 10000,
+			MinSignedPerWindow: math.LegacyMustNewDecFromStr("0.05"),
+		},
+	}
+
+	require.False(t, uptime.WillBeJailed())
+}
+
+func TestValidatorUptimeAtRisk(t *testing.T) {
+	t.Parallel()
+
+	uptime := ValidatorUptime{
+		Blocks: getValidatorBlocks(func(height int64) bool { return true }),
+		SlashingParams: &slashingTypes.Params{
+			SignedBlocksWindow: 100,
+			MinSignedPerWindow: math.LegacyMustNewDecFromStr("0.5"),
+		},
+	}
+
+	// 100 missed blocks counted, over the max of 50.
+	require.True(t, uptime.WillBeJailed())
+	require.Zero(t, uptime.TimeToJail())
+
+	uptime.Validator = &ValidatorInfo{
+		SigningInfo: &slashingTypes.ValidatorSigningInfo{MissedBlocksCounter: 10},
+	}
+
+	// -100 * ln((51 - 100) / (10 - 100)) = 60.8, so 61 blocks, 6 seconds each.
+	require.True(t, uptime.WillBeJailed())
+	require.Equal(t, 61*6*time.Second, uptime.TimeToJail())
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	ValidatorsSortByVotingPower = "vp"
+	ValidatorsSortByCommission  = "commission"
+	ValidatorsSortByUptime      = "uptime"
+)
+
+// ValidatorsSortOptions are the keys /top can sort validators by.
+var ValidatorsSortOptions = []string{
+	ValidatorsSortByVotingPower,
+	ValidatorsSortByCommission,
+	ValidatorsSortByUptime,
+}
+
+const validatorsTableRowFormat = "%-3s %-14s %6s %6s %6s %6s %7s %-4s %6s"
+
+// ValidatorsTable is a compact comparison of several validators on a chain,
+// used by /compare and /top.
+type ValidatorsTable struct {
+	Info   ChainValidatorsInfo
+	SortBy string
+	// Queries no validator was found by.
+	NotFound []string
+	// Whether there were more validators than shown.
+	Truncated bool
+	Error     error
+}
+
+// GetValidatorUptime returns the share of blocks signed by the validator
+// in the slashing window, and false if it's unknown.
+func (i ChainValidatorsInfo) GetValidatorUptime(validator ValidatorInfo) (float64, bool) {
+	if validator.SigningInfo == nil || i.SlashingParams == nil || i.SlashingParams.SignedBlocksWindow == 0 {
+		return 0, false
+	}
+
+	missed := float64(validator.SigningInfo.MissedBlocksCounter)
+	return 1 - missed/float64(i.SlashingParams.SignedBlocksWindow), true
+}
+
+// SortValidators sorts validators by the given key, active validators with known
+// values first: voting power and uptime descending, commission ascending.
+func (i ChainValidatorsInfo) SortValidators(sortBy string) {
+	sort.SliceStable(i.Validators, func(first, second int) bool {
+		a, b := i.Validators[first], i.Validators[second]
+
+		if a.Active() != b.Active() {
+			return a.Active()
+		}
+
+		switch sortBy {
+		case ValidatorsSortByCommission:
+			if a.Commission != b.Commission {
+				return a.Commission < b.Commission
+			}
+		case ValidatorsSortByUptime:
+			aUptime, aKnown := i.GetValidatorUptime(a)
+			bUptime, bKnown := i.GetValidatorUptime(b)
+
+			if aKnown != bKnown {
+				return aKnown
+			}
+
+			if aUptime != bUptime {
+				return aUptime > bUptime
+			}
+		}
+
+		return a.VotingPowerPercent > b.VotingPowerPercent
+	})
+}
+
+func (t ValidatorsTable) FormatHeader() string {
+	return fmt.Sprintf(validatorsTableRowFormat, "#", "Validator", "VP", "Comm", "Max", "MaxΔ", "Uptime", "Jail", "Self")
+}
+
+func (t ValidatorsTable) FormatRow(validator ValidatorInfo) string {
+	return t.Info.FormatValidatorRow(validator)
+}
+
+func (i ChainValidatorsInfo) FormatValidatorRow(validator ValidatorInfo) string {
+	rank := "-"
+	if validator.Active() {
+		rank = fmt.Sprintf("%d", validator.Rank)
+	}
+
+	moniker := []rune(validator.Moniker)
+	if len(moniker) > 14 {
+		moniker = append(moniker[:13], '…')
+	}
+
+	uptime := "?"
+	if value, known := i.GetValidatorUptime(validator); known {
+		uptime = fmt.Sprintf("%.2f%%", value*100)
+	}
+
+	jailed := "no"
+	if validator.Jailed {
+		jailed = "yes"
+	}
+
+	self := "?"
+	if validator.SelfDelegation != nil && validator.Tokens != nil && !validator.Tokens.Amount.IsZero() {
+		self = fmt.Sprintf("%.2f%%", validator.SelfDelegation.Amount.Quo(validator.Tokens.Amount).MustFloat64()*100)
+	}
+
+	return fmt.Sprintf(
+		validatorsTableRowFormat,
+		rank,
+		string(moniker),
+		fmt.Sprintf("%.2f%%", validator.VotingPowerPercent*100),
+		fmt.Sprintf("%.1f%%", validator.Commission*100),
+		fmt.Sprintf("%.1f%%", validator.CommissionMax*100),
+		fmt.Sprintf("%.1f%%", validator.CommissionMaxChangeRate*100),
+		uptime,
+		jailed,
+		self,
+	)
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"main/pkg/constants"
+	"testing"
+
+	slashingTypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainValidatorsInfoSortByUptime(t *testing.T) {
+	t.Parallel()
+
+	info := ChainValidatorsInfo{
+		SlashingParams: &slashingTypes.Params{SignedBlocksWindow: 100},
+		Validators: []ValidatorInfo{
+			{Moniker: "inactive", Status: "BOND_STATUS_UNBONDED", SigningInfo: &slashingTypes.ValidatorSigningInfo{}},
+			{Moniker: "unknown", Status: constants.ValidatorStatusBonded, VotingPowerPercent: 0.5},
+			{Moniker: "missing", Status: constants.ValidatorStatusBonded, SigningInfo: &slashingTypes.ValidatorSigningInfo{MissedBlocksCounter: 10}},
+			{Moniker: "perfect", Status: constants.ValidatorStatusBonded, SigningInfo: &slashingTypes.ValidatorSigningInfo{}},
+		},
+	}
+
+	info.SortValidators(ValidatorsSortByUptime)
+
+	monikers := make([]string, len(info.Validators))
+	for index, validator := range info.Validators {
+		monikers[index] = validator.Moniker
+	}
+
+	require.Equal(t, []string{"perfect", "missing", "unknown", "inactive"}, monikers)
+
+	uptime, known := info.GetValidatorUptime(info.Validators[1])
+	require.True(t, known)
+	require.InDelta(t, 0.9, uptime, 0.0001)
+}
@@ -12,6 +12,9 @@ type WalletLink struct {
 	UserID   string
 	Address  string
 	Alias    null.String
+	// Transfers to or from this wallet with all amounts below this value
+	// (in display denom) are not notified about.
+	NotifyThreshold float64
 }
 
 func (l *WalletLink) PrintAlias() string {
@@ -41,3 +44,35 @@ func (l *WalletLink) Validate() error {
 
 	return nil
 }
+
+// DerivedWallet is a wallet address converted to a chain bech32 prefix,
+// when linking the same key on all the chains sharing its coin type.
+type DerivedWallet struct {
+	Chain     *Chain
+	Explorers Explorers
+	Wallet    *WalletLink
+	// Set if the wallet does not exist on this chain, or its existence could not be checked.
+	Error         error
+	AlreadyLinked bool
+}
+
+func (w *DerivedWallet) IsLinked() bool {
+	return w.Error == nil && !w.AlreadyLinked
+}
+
+type DerivedWallets struct {
+	Address string
+	Alias   string
+	Wallets []*DerivedWallet
+}
+
+func (w DerivedWallets) GetLinkedCount() int {
+	count := 0
+	for _, wallet := range w.Wallets {
+		if wallet.IsLinked() {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	"github.com/guregu/null/v5"
+)
+
+type WatcherConfig struct {
+	Enabled  null.Bool     `default:"true" toml:"enabled"`
+	Interval time.Duration `default:"30s"  toml:"interval"`
+}
+
+func (c *WatcherConfig) Validate() error {
+	if c.Interval <= 0 {
+		return errors.New("interval should be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateWatcherConfigNoInterval(t *testing.T) {
+	t.Parallel()
+
+	config := &WatcherConfig{}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateWatcherConfigOk(t *testing.T) {
+	t.Parallel()
+
+	config := &WatcherConfig{Interval: 30 * time.Second}
+	err := config.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
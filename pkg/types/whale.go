@@ -0,0 +1,170 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/guregu/null/v5"
+)
+
+type WhaleAlertsConfig struct {
+	Enabled  null.Bool     `default:"true" toml:"enabled"`
+	Interval time.Duration `default:"5m"   toml:"interval"`
+}
+
+func (c *WhaleAlertsConfig) Validate() error {
+	if c.Interval <= 0 {
+		return errors.New("interval should be positive")
+	}
+
+	return nil
+}
+
+// WhaleAlertSubscription is a chat getting notified when a validator tokens
+// on a chain bound to it change by at least one of the thresholds between checks.
+type WhaleAlertSubscription struct {
+	Reporter string
+	ChatID   string
+	Chain    string
+	// Change in percents of the previous validator tokens.
+	PercentThreshold null.Float
+	// Change in tokens, in the display denom, so it requires the chain
+	// to have a display denom for its base denom.
+	TokensThreshold null.Float
+}
+
+// SetThreshold parses a threshold like "5%" as a percent one, and like "1000" as a tokens one.
+func (s *WhaleAlertSubscription) SetThreshold(value string) error {
+	isPercent := strings.HasSuffix(value, "%")
+
+	threshold, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+	if err != nil {
+		return fmt.Errorf("invalid threshold: %s", value)
+	}
+
+	if threshold <= 0 {
+		return errors.New("threshold should be positive")
+	}
+
+	if isPercent {
+		if s.PercentThreshold.Valid {
+			return errors.New("percent threshold is set twice")
+		}
+
+		s.PercentThreshold = null.FloatFrom(threshold)
+		return nil
+	}
+
+	if s.TokensThreshold.Valid {
+		return errors.New("tokens threshold is set twice")
+	}
+
+	s.TokensThreshold = null.FloatFrom(threshold)
+	return nil
+}
+
+func (s *WhaleAlertSubscription) Matches(change *ValidatorTokensChange) bool {
+	if s.PercentThreshold.Valid {
+		if percent, ok := change.GetPercentChange(); ok && math.Abs(percent) >= s.PercentThreshold.Float64 {
+			return true
+		}
+	}
+
+	if threshold, ok := s.GetTokensThreshold(change.Change.DenomInfo); ok {
+		return change.Current.Sub(change.Previous).Abs().ToLegacyDec().GTE(threshold)
+	}
+
+	return false
+}
+
+// GetTokensThreshold returns the tokens threshold in the base denom, so it's compared
+// with the tokens change precisely. It cannot be converted without the display denom.
+func (s *WhaleAlertSubscription) GetTokensThreshold(denom *Denom) (cosmosMath.LegacyDec, bool) {
+	if !s.TokensThreshold.Valid || denom == nil {
+		return cosmosMath.LegacyDec{}, false
+	}
+
+	threshold, err := cosmosMath.LegacyNewDecFromStr(strconv.FormatFloat(s.TokensThreshold.Float64, 'f', cosmosMath.LegacyPrecision, 64))
+	if err != nil {
+		return cosmosMath.LegacyDec{}, false
+	}
+
+	return threshold.Mul(cosmosMath.LegacyNewDec(10).Power(uint64(denom.DenomExponent))), true
+}
+
+func (s *WhaleAlertSubscription) FormatThresholds() string {
+	thresholds := make([]string, 0, 2)
+
+	if s.PercentThreshold.Valid {
+		thresholds = append(thresholds, strconv.FormatFloat(s.PercentThreshold.Float64, 'f', -1, 64)+"%")
+	}
+
+	if s.TokensThreshold.Valid {
+		thresholds = append(thresholds, strconv.FormatFloat(s.TokensThreshold.Float64, 'f', -1, 64)+" tokens")
+	}
+
+	return strings.Join(thresholds, " or ")
+}
+
+// ValidatorsSnapshot is the validators tokens of a chain, in the base denom,
+// keyed by the validator operator address.
+type ValidatorsSnapshot struct {
+	Chain     string
+	Tokens    map[string]cosmosMath.Int
+	CreatedAt time.Time
+}
+
+type ValidatorTokensChange struct {
+	OperatorAddress string
+	Moniker         string
+	// Tokens in the base denom.
+	Previous cosmosMath.Int
+	Current  cosmosMath.Int
+	// The difference, converted to the display denom, negative if the tokens decreased.
+	Change *Amount
+}
+
+// GetPercentChange returns the change in percents of the previous tokens,
+// if the validator had any.
+func (c *ValidatorTokensChange) GetPercentChange() (float64, bool) {
+	if c.Previous.IsZero() {
+		return 0, false
+	}
+
+	change := c.Current.Sub(c.Previous).ToLegacyDec().Quo(c.Previous.ToLegacyDec())
+	return change.MustFloat64() * 100, true
+}
+
+func (c *ValidatorTokensChange) IsIncrease() bool {
+	return c.Current.GT(c.Previous)
+}
+
+func (c *ValidatorTokensChange) FormatPercentChange() string {
+	percent, ok := c.GetPercentChange()
+	if !ok {
+		return "new"
+	}
+
+	return fmt.Sprintf("%+.2f%%", percent)
+}
+
+// GetAbsChange returns the change amount without its sign, for displaying.
+func (c *ValidatorTokensChange) GetAbsChange() *Amount {
+	if c.Change.Amount.IsNegative() {
+		return c.Change.Mul(cosmosMath.LegacyNewDec(-1))
+	}
+
+	return c.Change
+}
+
+type WhaleAlert struct {
+	Chain     *Chain
+	Explorers Explorers
+	Since     time.Time
+	Changes   []*ValidatorTokensChange
+}
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateWhaleAlertsConfigInvalidInterval(t *testing.T) {
+	t.Parallel()
+
+	config := &WhaleAlertsConfig{}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateWhaleAlertsConfigOk(t *testing.T) {
+	t.Parallel()
+
+	config := &WhaleAlertsConfig{Interval: 5 * time.Minute}
+	err := config.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
+
+func TestWhaleAlertSubscriptionSetThreshold(t *testing.T) {
+	t.Parallel()
+
+	subscription := &WhaleAlertSubscription{}
+	require.Error(t, subscription.SetThreshold("test"))
+	require.Error(t, subscription.SetThreshold("test%"))
+	require.Error(t, subscription.SetThreshold("0"))
+	require.Error(t, subscription.SetThreshold("-5%"))
+
+	require.NoError(t, subscription.SetThreshold("5%"))
+	require.Error(t, subscription.SetThreshold("10%"))
+	require.NoError(t, subscription.SetThreshold("100000"))
+	require.Error(t, subscription.SetThreshold("1000"))
+
+	require.InDelta(t, 5, subscription.PercentThreshold.Float64, 0.001)
+	require.InDelta(t, 100000, subscription.TokensThreshold.Float64, 0.001)
+	require.Equal(t, "5% or 100000 tokens", subscription.FormatThresholds())
+}
+
+func TestWhaleAlertSubscriptionMatches(t *testing.T) {
+	t.Parallel()
+
+	change := &ValidatorTokensChange{
+		Previous: math.NewInt(1000000000),
+		Current:  math.NewInt(900000000),
+		Change: &Amount{
+			Amount:    math.LegacyNewDec(-100),
+			Denom:     "ATOM",
+			DenomInfo: &Denom{Denom: "uatom", DisplayDenom: "ATOM", DenomExponent: 6},
+		},
+	}
+
+	percent := &WhaleAlertSubscription{}
+	require.NoError(t, percent.SetThreshold("10%"))
+	require.True(t, percent.Matches(change))
+
+	require.NoError(t, percent.SetThreshold("1000"))
+	require.True(t, percent.Matches(change))
+
+	tokens := &WhaleAlertSubscription{}
+	require.NoError(t, tokens.SetThreshold("101"))
+	require.False(t, tokens.Matches(change))
+
+	require.NoError(t, tokens.SetThreshold("11%"))
+	require.False(t, tokens.Matches(change))
+
+	exact := &WhaleAlertSubscription{}
+	require.NoError(t, exact.SetThreshold("100"))
+	require.True(t, exact.Matches(change))
+
+	newValidator := &ValidatorTokensChange{
+		Previous: math.ZeroInt(),
+		Current:  math.NewInt(100),
+		Change:   &Amount{Amount: math.LegacyNewDec(100), Denom: "ATOM"},
+	}
+	require.False(t, percent.Matches(newValidator))
+}
+
+func TestWhaleAlertSubscriptionMatchesNoDisplayDenom(t *testing.T) {
+	t.Parallel()
+
+	// without a display denom, the change stays in the base denom,
+	// and cannot be compared with a threshold in tokens
+	change := &ValidatorTokensChange{
+		Previous: math.NewInt(100000),
+		Current:  math.NewInt(90000),
+		Change:   &Amount{Amount: math.LegacyNewDec(-10000), Denom: "uatom"},
+	}
+
+	subscription := &WhaleAlertSubscription{}
+	require.NoError(t, subscription.SetThreshold("1000"))
+	require.False(t, subscription.Matches(change))
+}
+
+func TestValidatorTokensChangePercent(t *testing.T) {
+	t.Parallel()
+
+	decrease := &ValidatorTokensChange{
+		Previous: math.NewInt(1000),
+		Current:  math.NewInt(900),
+		Change:   &Amount{Amount: math.LegacyNewDec(-100), Denom: "ATOM"},
+	}
+
+	percent, ok := decrease.GetPercentChange()
+	require.True(t, ok)
+	require.InDelta(t, -10, percent, 0.001)
+	require.False(t, decrease.IsIncrease())
+	require.Equal(t, "-10.00%", decrease.FormatPercentChange())
+	require.Equal(t, "100.000000000000000000", decrease.GetAbsChange().Amount.String())
+
+	newValidator := &ValidatorTokensChange{
+		Previous: math.ZeroInt(),
+		Current:  math.NewInt(100),
+		Change:   &Amount{Amount: math.LegacyNewDec(100), Denom: "ATOM"},
+	}
+
+	_, ok = newValidator.GetPercentChange()
+	require.False(t, ok)
+	require.True(t, newValidator.IsIncrease())
+	require.Equal(t, "new", newValidator.FormatPercentChange())
+	require.Equal(t, newValidator.Change, newValidator.GetAbsChange())
+}
@@ -0,0 +1,141 @@
+package uptime
+
+import (
+	"main/pkg/types"
+	"sync"
+)
+
+// Store keeps the signatures of the latest blocks per chain, as a sliding window:
+// once there are more blocks than the window size, the oldest ones are dropped.
+type Store struct {
+	Window int
+	Blocks map[string]*Ring
+
+	mutex sync.Mutex
+}
+
+// Ring keeps the blocks of a chain in a buffer of the window size, indexed
+// by the block height, so adding a block overwrites the one that went out
+// of the window, without moving or sorting the others.
+type Ring struct {
+	Blocks       []*types.BlockSignatures
+	LatestHeight int64
+}
+
+func NewStore(window int) *Store {
+	return &Store{
+		Window: window,
+		Blocks: map[string]*Ring{},
+	}
+}
+
+func NewRing(size int) *Ring {
+	return &Ring{Blocks: make([]*types.BlockSignatures, size)}
+}
+
+func (s *Store) SetWindow(window int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.Window = window
+
+	// the blocks positions depend on the window size, so moving them to new buffers
+	for chain, ring := range s.Blocks {
+		newRing := NewRing(window)
+		for _, block := range ring.GetBlocks() {
+			newRing.Add(*block)
+		}
+
+		s.Blocks[chain] = newRing
+	}
+}
+
+func (s *Store) AddBlock(chain string, block types.BlockSignatures) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ring, ok := s.Blocks[chain]
+	if !ok {
+		ring = NewRing(s.Window)
+		s.Blocks[chain] = ring
+	}
+
+	ring.Add(block)
+}
+
+// GetValidatorBlocks returns the tracked blocks at which the validator
+// was in the active set, and whether it signed them, oldest first.
+func (s *Store) GetValidatorBlocks(chain string, consensusAddress string) []types.ValidatorBlock {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	validatorBlocks := make([]types.ValidatorBlock, 0)
+
+	ring, ok := s.Blocks[chain]
+	if !ok {
+		return validatorBlocks
+	}
+
+	for _, block := range ring.GetBlocks() {
+		signed, found := block.Signatures[consensusAddress]
+		if !found {
+			continue
+		}
+
+		validatorBlocks = append(validatorBlocks, types.ValidatorBlock{
+			Height: block.Height,
+			Time:   block.Time,
+			Signed: signed,
+		})
+	}
+
+	return validatorBlocks
+}
+
+func (s *Store) GetBlocksCount(chain string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ring, ok := s.Blocks[chain]
+	if !ok {
+		return 0
+	}
+
+	return len(ring.GetBlocks())
+}
+
+func (r *Ring) Add(block types.BlockSignatures) {
+	size := int64(len(r.Blocks))
+	if size == 0 {
+		return
+	}
+
+	// older than the latest blocks window
+	if block.Height <= r.LatestHeight-size {
+		return
+	}
+
+	// After a websocket reconnect the same block can arrive twice.
+	index := block.Height % size
+	if existing := r.Blocks[index]; existing != nil && existing.Height >= block.Height {
+		return
+	}
+
+	r.Blocks[index] = &block
+	r.LatestHeight = max(r.LatestHeight, block.Height)
+}
+
+// GetBlocks returns the blocks within the window, oldest first. Some heights
+// might be missing, if these blocks were not received.
+func (r *Ring) GetBlocks() []*types.BlockSignatures {
+	size := int64(len(r.Blocks))
+	blocks := make([]*types.BlockSignatures, 0, size)
+
+	for height := max(r.LatestHeight-size+1, 0); height <= r.LatestHeight && size > 0; height++ {
+		if block := r.Blocks[height%size]; block != nil && block.Height == height {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return blocks
+}
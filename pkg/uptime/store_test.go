@@ -0,0 +1,79 @@
+package uptime_test
+
+import (
+	"main/pkg/types"
+	uptimePkg "main/pkg/uptime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreKeepsWindow(t *testing.T) {
+	t.Parallel()
+
+	store := uptimePkg.NewStore(2)
+	now := time.Now()
+
+	for height := int64(1); height <= 3; height++ {
+		store.AddBlock("chain", types.BlockSignatures{
+			Height:     height,
+			Time:       now.Add(time.Duration(height) * time.Second),
+			Signatures: map[string]bool{"AAAA": height != 2},
+		})
+	}
+
+	require.Equal(t, 2, store.GetBlocksCount("chain"))
+
+	blocks := store.GetValidatorBlocks("chain", "AAAA")
+	require.Len(t, blocks, 2)
+	require.Equal(t, int64(2), blocks[0].Height)
+	require.False(t, blocks[0].Signed)
+	require.Equal(t, int64(3), blocks[1].Height)
+	require.True(t, blocks[1].Signed)
+}
+
+func TestStoreSkipsDuplicatesAndUnknownValidators(t *testing.T) {
+	t.Parallel()
+
+	store := uptimePkg.NewStore(10)
+
+	store.AddBlock("chain", types.BlockSignatures{Height: 2, Signatures: map[string]bool{"AAAA": true}})
+	store.AddBlock("chain", types.BlockSignatures{Height: 1, Signatures: map[string]bool{"BBBB": true}})
+	store.AddBlock("chain", types.BlockSignatures{Height: 2, Signatures: map[string]bool{"AAAA": false}})
+
+	require.Equal(t, 2, store.GetBlocksCount("chain"))
+
+	blocks := store.GetValidatorBlocks("chain", "AAAA")
+	require.Len(t, blocks, 1)
+	require.True(t, blocks[0].Signed)
+
+	require.Empty(t, store.GetValidatorBlocks("other", "AAAA"))
+}
+
+func TestStoreOutOfOrderAndOutdatedBlocks(t *testing.T) {
+	t.Parallel()
+
+	store := uptimePkg.NewStore(3)
+
+	for _, height := range []int64{5, 3, 4, 1, 6} {
+		store.AddBlock("chain", types.BlockSignatures{
+			Height:     height,
+			Signatures: map[string]bool{"AAAA": true},
+		})
+	}
+
+	blocks := store.GetValidatorBlocks("chain", "AAAA")
+	require.Len(t, blocks, 3)
+	require.Equal(t, int64(4), blocks[0].Height)
+	require.Equal(t, int64(5), blocks[1].Height)
+	require.Equal(t, int64(6), blocks[2].Height)
+
+	store.SetWindow(2)
+	require.Equal(t, 2, store.GetBlocksCount("chain"))
+
+	blocks = store.GetValidatorBlocks("chain", "AAAA")
+	require.Len(t, blocks, 2)
+	require.Equal(t, int64(5), blocks[0].Height)
+	require.Equal(t, int64(6), blocks[1].Height)
+}
@@ -1,7 +1,10 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"sort"
+	"strings"
 
 	"cosmossdk.io/math"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -36,3 +39,24 @@ func FindValidatorRank(validators []stakingTypes.Validator, valoper string) int
 
 	return 0
 }
+
+// GetReceivedIBCDenom returns the denom of the tokens received via IBC on the
+// destination chain, given the ICS-20 packet denom. If the tokens are returning
+// to the chain they came from, the source port and channel prefix is removed,
+// otherwise the destination port and channel are prepended to the trace.
+func GetReceivedIBCDenom(sourcePort, sourceChannel, destPort, destChannel, denom string) string {
+	sourcePrefix := sourcePort + "/" + sourceChannel + "/"
+
+	trace := destPort + "/" + destChannel + "/" + denom
+	if strings.HasPrefix(denom, sourcePrefix) {
+		trace = strings.TrimPrefix(denom, sourcePrefix)
+	}
+
+	// native denom, no trace
+	if !strings.Contains(trace, "/") {
+		return trace
+	}
+
+	hash := sha256.Sum256([]byte(trace))
+	return "ibc/" + strings.ToUpper(hex.EncodeToString(hash[:]))
+}
@@ -221,6 +221,11 @@ func FormatDec(dec cosmosMath.LegacyDec) string {
 }
 
 func ConvertBech32Prefix(address, newPrefix string) (string, error) {
+	// an empty prefix would be encoded as well, into an address of no chain
+	if newPrefix == "" {
+		return "", fmt.Errorf("empty bech32 prefix")
+	}
+
 	_, addressRaw, err := bech32.Decode(address)
 	if err != nil {
 		return "", err
@@ -229,6 +234,28 @@ func ConvertBech32Prefix(address, newPrefix string) (string, error) {
 	return bech32.Encode(newPrefix, addressRaw)
 }
 
+func APRToAPY(apr float64, compoundPeriods int) float64 {
+	return math.Pow(1+apr/float64(compoundPeriods), float64(compoundPeriods)) - 1
+}
+
+// FindOptimalCompoundPeriods returns how many times a year the rewards should be restaked
+// to earn the most, given the fee paid for each restake, and the rewards earned in a year
+// with this frequency. 0 periods means restaking is not worth it.
+func FindOptimalCompoundPeriods(staked, apr, fee float64, maxPeriods int) (int, float64) {
+	bestPeriods := 0
+	bestRewards := staked * apr
+
+	for periods := 1; periods <= maxPeriods; periods++ {
+		rewards := staked*APRToAPY(apr, periods) - float64(periods)*fee
+		if rewards > bestRewards {
+			bestPeriods = periods
+			bestRewards = rewards
+		}
+	}
+
+	return bestPeriods, bestRewards
+}
+
 func BoolToFloat64(b bool) float64 {
 	if b {
 		return 1
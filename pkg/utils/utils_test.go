@@ -143,6 +143,37 @@ func TestFormatPercent(t *testing.T) {
 	assert.Equal(t, "10.00%", FormatPercent(0.1))
 }
 
+func TestAPRToAPY(t *testing.T) {
+	t.Parallel()
+	assert.InDelta(t, 0.1051, APRToAPY(0.1, 365), 0.0001)
+	assert.InDelta(t, 0.1, APRToAPY(0.1, 1), 0.0001)
+	assert.Zero(t, APRToAPY(0, 365))
+}
+
+func TestFindOptimalCompoundPeriodsNoFee(t *testing.T) {
+	t.Parallel()
+
+	periods, rewards := FindOptimalCompoundPeriods(100, 0.1, 0, 365)
+	assert.Equal(t, 365, periods)
+	assert.InDelta(t, 10.5156, rewards, 0.0001)
+}
+
+func TestFindOptimalCompoundPeriodsWithFee(t *testing.T) {
+	t.Parallel()
+
+	periods, rewards := FindOptimalCompoundPeriods(100, 0.1, 0.01, 365)
+	assert.Equal(t, 7, periods)
+	assert.InDelta(t, 10.3689, rewards, 0.0001)
+}
+
+func TestFindOptimalCompoundPeriodsNotWorthIt(t *testing.T) {
+	t.Parallel()
+
+	periods, rewards := FindOptimalCompoundPeriods(100, 0.1, 10, 365)
+	assert.Zero(t, periods)
+	assert.InDelta(t, 10, rewards, 0.0001)
+}
+
 func TestFormatFloat(t *testing.T) {
 	t.Parallel()
 	assert.Equal(t, "10.00", FormatFloat(10.00))
@@ -186,6 +217,16 @@ func TestConvertBech32PrefixInvalid(t *testing.T) {
 	require.Error(t, err, "Error should be present!")
 }
 
+func TestConvertBech32PrefixEmpty(t *testing.T) {
+	t.Parallel()
+
+	_, err := ConvertBech32Prefix(
+		"cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		"",
+	)
+	require.Error(t, err, "Error should be present!")
+}
+
 func TestConvertBech32PrefixValid(t *testing.T) {
 	t.Parallel()
 
@@ -208,3 +249,23 @@ func TestBoolToFloat64(t *testing.T) {
 	require.InDelta(t, 1, BoolToFloat64(true), 0.01)
 	require.Zero(t, BoolToFloat64(false))
 }
+
+func TestGetReceivedIBCDenomForeign(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(
+		t,
+		"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		GetReceivedIBCDenom("transfer", "channel-141", "transfer", "channel-0", "uatom"),
+	)
+}
+
+func TestGetReceivedIBCDenomReturning(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(
+		t,
+		"uatom",
+		GetReceivedIBCDenom("transfer", "channel-0", "transfer", "channel-141", "transfer/channel-0/uatom"),
+	)
+}
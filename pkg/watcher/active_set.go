@@ -0,0 +1,132 @@
+package watcher
+
+import (
+	"fmt"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	notifierPkg "main/pkg/notifier"
+	"main/pkg/types"
+	"slices"
+
+	"github.com/rs/zerolog"
+)
+
+// ActiveSetWatcher checks how far the linked validators are from being pushed out
+// of the active set, and warns the users having them linked once their margin
+// drops below the threshold. Users are warned again only after the margin recovers.
+type ActiveSetWatcher struct {
+	Logger      zerolog.Logger
+	Config      types.ActiveSetConfig
+	Database    *databasePkg.Database
+	DataFetcher *datafetcher.DataFetcher
+	Notifier    *notifierPkg.Notifier
+}
+
+func NewActiveSetWatcher(
+	config types.ActiveSetConfig,
+	logger *zerolog.Logger,
+	database *databasePkg.Database,
+	dataFetcher *datafetcher.DataFetcher,
+	notifier *notifierPkg.Notifier,
+) *ActiveSetWatcher {
+	return &ActiveSetWatcher{
+		Logger:      logger.With().Str("component", "active_set_watcher").Logger(),
+		Config:      config,
+		Database:    database,
+		DataFetcher: dataFetcher,
+		Notifier:    notifier,
+	}
+}
+
+func (w *ActiveSetWatcher) Enabled() bool {
+	return w.Config.Enabled.Bool
+}
+
+func (w *ActiveSetWatcher) Tick() error {
+	chains, err := w.Database.GetAllChains()
+	if err != nil {
+		return fmt.Errorf("error getting chains: %w", err)
+	}
+
+	for _, chain := range chains {
+		if err := w.ProcessChain(chain); err != nil {
+			w.Logger.Error().
+				Err(err).
+				Str("chain", chain.Name).
+				Msg("Error checking active set")
+		}
+	}
+
+	return nil
+}
+
+func (w *ActiveSetWatcher) ProcessChain(chain *types.Chain) error {
+	addresses, err := w.Database.GetValidatorLinksAddresses(chain.Name)
+	if err != nil {
+		return fmt.Errorf("error getting linked validators: %w", err)
+	}
+
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	activeSet, err := w.DataFetcher.GetActiveSet(chain, addresses)
+	if err != nil {
+		return fmt.Errorf("error getting active set: %w", err)
+	}
+
+	warned, err := w.Database.GetActiveSetWarnings(chain.Name)
+	if err != nil {
+		return fmt.Errorf("error getting active set warnings: %w", err)
+	}
+
+	explorers, err := w.Database.GetExplorersByChains([]string{chain.Name})
+	if err != nil {
+		return fmt.Errorf("error getting explorers: %w", err)
+	}
+
+	for _, validator := range activeSet.LinkedValidators {
+		isWarned := slices.Contains(warned, validator.OperatorAddress)
+
+		if !w.IsMarginLow(validator) {
+			if isWarned {
+				if err := w.Database.DeleteActiveSetWarning(chain.Name, validator.OperatorAddress); err != nil {
+					return fmt.Errorf("error deleting active set warning: %w", err)
+				}
+			}
+
+			continue
+		}
+
+		if isWarned {
+			continue
+		}
+
+		if err := w.Database.InsertActiveSetWarning(chain.Name, validator.OperatorAddress); err != nil {
+			return fmt.Errorf("error saving active set warning: %w", err)
+		}
+
+		w.Notifier.Publish(&types.NotificationEvent{
+			Type:             types.NotificationTypeActiveSet,
+			Chain:            chain.Name,
+			ValidatorAddress: validator.OperatorAddress,
+			Template:         "active_set_warning",
+			Data: &types.ActiveSetWarning{
+				Chain:         chain,
+				Explorers:     explorers,
+				MaxValidators: activeSet.MaxValidators,
+				Validator:     validator,
+			},
+		})
+	}
+
+	return nil
+}
+
+// IsMarginLow returns whether an active validator is close enough to the active set edge
+// to warn about it. Inactive and jailed validators are not warned about, as they are already out.
+func (w *ActiveSetWatcher) IsMarginLow(validator *types.ActiveSetValidator) bool {
+	return validator.Active &&
+		validator.HasMargin() &&
+		validator.MarginPercent*100 < w.Config.MarginThreshold
+}
@@ -0,0 +1,293 @@
+package watcher
+
+import (
+	"errors"
+	"main/assets"
+	converterPkg "main/pkg/converter"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	activeSetSafeValidator     = "cosmosvaloper1s5kufhghcyqkpp0ps0w9kn3lmn9wp8g5lkk07e"
+	activeSetWarnedValidator   = "cosmosvaloper122j3zmqdl6d2g64qmjuqzj65gfejsvjp07yljn"
+	activeSetLowValidator      = "cosmosvaloper136zvatxwq7xfhnaxku6np2c3n59j3p39z5u77g"
+	activeSetInactiveValidator = "cosmosvaloper12xx74t5sx3vgyew7es9h8e9py4ttts6zcg3vxk"
+)
+
+func getActiveSetWatcher(t *testing.T, interacter *StubInteracter) (*ActiveSetWatcher, sqlmock.Sqlmock) {
+	t.Helper()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	database.SetClient(db)
+
+	watcher := NewActiveSetWatcher(
+		types.ActiveSetConfig{Interval: 10 * time.Minute, MarginThreshold: 5},
+		logger,
+		database,
+		dataFetcher,
+		getNotifier(database, metricsManager, interacter),
+	)
+
+	return watcher, mock
+}
+
+func registerActiveSetResponders() {
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/validators?pagination.limit=1000",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/params",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("staking-params.json")))
+}
+
+func expectActiveSetChains(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"))
+}
+
+func expectActiveSetFetched(mock sqlmock.Sqlmock, addresses ...string) {
+	rows := sqlmock.NewRows([]string{"address"})
+	for _, address := range addresses {
+		rows.AddRow(address)
+	}
+
+	mock.ExpectQuery("SELECT DISTINCT address FROM validator_links").
+		WithArgs("chain").
+		WillReturnRows(rows)
+
+	for range 2 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, nil, false))
+}
+
+func expectActiveSetExplorers(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"chain",
+			"name",
+			"proposal_link_pattern",
+			"wallet_link_pattern",
+			"validator_link_pattern",
+			"main_link",
+			"tx_link_pattern",
+		}))
+}
+
+//nolint:paralleltest // disabled
+func TestActiveSetWatcherErrorGettingChains(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getActiveSetWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
+		WillReturnError(errors.New("custom error"))
+
+	require.Error(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestActiveSetWatcherNoLinkedValidators(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getActiveSetWatcher(t, interacter)
+
+	expectActiveSetChains(mock)
+
+	mock.ExpectQuery("SELECT DISTINCT address FROM validator_links").
+		WithArgs("chain").
+		WillReturnRows(sqlmock.NewRows([]string{"address"}))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestActiveSetWatcherErrorGettingValidators(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	interacter := &StubInteracter{}
+	watcher, mock := getActiveSetWatcher(t, interacter)
+
+	expectActiveSetChains(mock)
+
+	mock.ExpectQuery("SELECT DISTINCT address FROM validator_links").
+		WithArgs("chain").
+		WillReturnRows(sqlmock.NewRows([]string{"address"}).AddRow(activeSetLowValidator))
+
+	for range 2 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestActiveSetWatcherErrorGettingWarnings(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerActiveSetResponders()
+
+	interacter := &StubInteracter{}
+	watcher, mock := getActiveSetWatcher(t, interacter)
+
+	expectActiveSetChains(mock)
+	expectActiveSetFetched(mock, activeSetLowValidator)
+
+	mock.ExpectQuery("SELECT address FROM active_set_warnings").
+		WillReturnError(errors.New("custom error"))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestActiveSetWatcherErrorSavingWarning(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerActiveSetResponders()
+
+	interacter := &StubInteracter{}
+	watcher, mock := getActiveSetWatcher(t, interacter)
+
+	expectActiveSetChains(mock)
+	expectActiveSetFetched(mock, activeSetLowValidator)
+
+	mock.ExpectQuery("SELECT address FROM active_set_warnings").
+		WillReturnRows(sqlmock.NewRows([]string{"address"}))
+
+	expectActiveSetExplorers(mock)
+
+	mock.ExpectExec("INSERT INTO active_set_warnings").
+		WillReturnError(errors.New("custom error"))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestActiveSetWatcherAlreadyWarned(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerActiveSetResponders()
+
+	interacter := &StubInteracter{}
+	watcher, mock := getActiveSetWatcher(t, interacter)
+
+	expectActiveSetChains(mock)
+	expectActiveSetFetched(mock, activeSetLowValidator)
+
+	mock.ExpectQuery("SELECT address FROM active_set_warnings").
+		WillReturnRows(sqlmock.NewRows([]string{"address"}).AddRow(activeSetLowValidator))
+
+	expectActiveSetExplorers(mock)
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestActiveSetWatcherOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerActiveSetResponders()
+
+	interacter := &StubInteracter{}
+	watcher, mock := getActiveSetWatcher(t, interacter)
+
+	expectActiveSetChains(mock)
+	expectActiveSetFetched(
+		mock,
+		activeSetSafeValidator,
+		activeSetWarnedValidator,
+		activeSetLowValidator,
+		activeSetInactiveValidator,
+	)
+
+	mock.ExpectQuery("SELECT address FROM active_set_warnings").
+		WillReturnRows(sqlmock.NewRows([]string{"address"}).
+			AddRow(activeSetWarnedValidator).
+			AddRow(activeSetInactiveValidator))
+
+	// fetched once for all the validators warned about
+	expectActiveSetExplorers(mock)
+
+	// the previously warned validator margin has recovered above the threshold
+	mock.ExpectExec("DELETE FROM active_set_warnings").
+		WithArgs("chain", activeSetWarnedValidator).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	mock.ExpectExec("INSERT INTO active_set_warnings").
+		WithArgs("chain", activeSetLowValidator).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	mock.ExpectQuery("SELECT reporter, user_id FROM validator_links").
+		WithArgs("chain", activeSetLowValidator).
+		WillReturnRows(sqlmock.NewRows([]string{"reporter", "user_id"}).AddRow("telegram", "1"))
+
+	expectNotificationSettings(mock)
+
+	// the validator that is already out of the active set is not warned about anymore
+	mock.ExpectExec("DELETE FROM active_set_warnings").
+		WithArgs("chain", activeSetInactiveValidator).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	require.Len(t, interacter.Notifications, 1)
+	require.Len(t, interacter.Notifications["1"], 1)
+
+	event := interacter.Notifications["1"][0]
+	require.Equal(t, types.NotificationTypeActiveSet, event.Type)
+	require.Equal(t, "active_set_warning", event.Template)
+	require.Equal(t, activeSetLowValidator, event.ValidatorAddress)
+
+	warning, ok := event.Data.(*types.ActiveSetWarning)
+	require.True(t, ok)
+	require.Equal(t, uint32(200), warning.MaxValidators)
+	require.Equal(t, 200, warning.Validator.Rank)
+	require.Equal(t, "ATOM", warning.Validator.Margin.Denom)
+}
@@ -0,0 +1,219 @@
+package watcher
+
+import (
+	"encoding/json"
+	"main/pkg/constants"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// BlocksWatcher subscribes to new blocks of every chain having RPC nodes,
+// and records which validators signed them, to track their uptime.
+type BlocksWatcher struct {
+	Logger       zerolog.Logger
+	Config       types.UptimeConfig
+	Database     *databasePkg.Database
+	DataFetcher  *datafetcher.DataFetcher
+	NodesManager *tendermint.NodeManager
+
+	// Chains which new blocks are already subscribed to.
+	Subscribed map[string]bool
+	// Active validators hex addresses per chain, in the same order
+	// as CometBFT returns signatures, and the height they were fetched at.
+	ValidatorSets       map[string][]string
+	ValidatorSetHeights map[string]int64
+	Mutex               sync.Mutex
+
+	StopChannel chan bool
+}
+
+func NewBlocksWatcher(
+	config types.UptimeConfig,
+	logger *zerolog.Logger,
+	database *databasePkg.Database,
+	dataFetcher *datafetcher.DataFetcher,
+	nodesManager *tendermint.NodeManager,
+) *BlocksWatcher {
+	dataFetcher.UptimeStore.SetWindow(config.BlocksWindow)
+
+	return &BlocksWatcher{
+		Logger:              logger.With().Str("component", "blocks_watcher").Logger(),
+		Config:              config,
+		Database:            database,
+		DataFetcher:         dataFetcher,
+		NodesManager:        nodesManager,
+		Subscribed:          map[string]bool{},
+		ValidatorSets:       map[string][]string{},
+		ValidatorSetHeights: map[string]int64{},
+		StopChannel:         make(chan bool),
+	}
+}
+
+func (w *BlocksWatcher) Enabled() bool {
+	return w.Config.Enabled.Bool
+}
+
+func (w *BlocksWatcher) Start() {
+	ticker := time.NewTicker(constants.BlocksWatcherRefreshInterval)
+	defer ticker.Stop()
+
+	w.Tick()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.Tick()
+		case <-w.StopChannel:
+			w.Logger.Info().Msg("Shutting down...")
+			return
+		}
+	}
+}
+
+func (w *BlocksWatcher) Stop() {
+	w.StopChannel <- true
+	w.NodesManager.Websockets.Stop()
+}
+
+// Tick subscribes to new blocks of chains that were not subscribed to yet,
+// as chains and their RPC nodes can be added while the app is running.
+func (w *BlocksWatcher) Tick() {
+	chains, err := w.Database.GetAllChains()
+	if err != nil {
+		w.Logger.Error().Err(err).Msg("Error getting chains")
+		return
+	}
+
+	for _, chain := range chains {
+		w.Mutex.Lock()
+		subscribed := w.Subscribed[chain.Name]
+		w.Mutex.Unlock()
+
+		if subscribed {
+			continue
+		}
+
+		nodes, err := w.Database.GetRPCNodes(chain)
+		if err != nil {
+			w.Logger.Error().Err(err).Str("chain", chain.Name).Msg("Error getting RPC nodes")
+			continue
+		}
+
+		if len(nodes) == 0 {
+			w.Logger.Trace().Str("chain", chain.Name).Msg("Chain has no RPC nodes, not tracking uptime")
+			continue
+		}
+
+		w.Logger.Info().Str("chain", chain.Name).Msg("Subscribing to new blocks")
+
+		w.NodesManager.Subscribe(chain, constants.NewBlockEventQuery, func(event types.CometBFTEvent) {
+			w.ProcessBlock(chain, event)
+		})
+
+		w.Mutex.Lock()
+		w.Subscribed[chain.Name] = true
+		w.Mutex.Unlock()
+	}
+}
+
+func (w *BlocksWatcher) ProcessBlock(chain *types.Chain, event types.CometBFTEvent) {
+	var newBlock types.CometBFTNewBlock
+	if err := json.Unmarshal(event.Data.Value, &newBlock); err != nil {
+		w.Logger.Error().Err(err).Str("chain", chain.Name).Msg("Error unmarshalling new block")
+		return
+	}
+
+	lastCommit := newBlock.Block.LastCommit
+
+	// The first block has no last commit.
+	height, err := strconv.ParseInt(lastCommit.Height, 10, 64)
+	if err != nil || height == 0 {
+		return
+	}
+
+	validatorSet, err := w.GetValidatorSet(chain, height, lastCommit.Signatures)
+	if err != nil {
+		w.Logger.Error().
+			Err(err).
+			Str("chain", chain.Name).
+			Int64("height", height).
+			Msg("Error getting validator set")
+		return
+	}
+
+	if len(validatorSet) != len(lastCommit.Signatures) {
+		w.Logger.Warn().
+			Str("chain", chain.Name).
+			Int64("height", height).
+			Int("validators", len(validatorSet)).
+			Int("signatures", len(lastCommit.Signatures)).
+			Msg("Validator set does not match signatures, skipping block")
+		return
+	}
+
+	signatures := make(map[string]bool, len(lastCommit.Signatures))
+	for index, signature := range lastCommit.Signatures {
+		signatures[validatorSet[index]] = signature.Signed()
+	}
+
+	w.DataFetcher.UptimeStore.AddBlock(chain.Name, types.BlockSignatures{
+		Height:     height,
+		Time:       newBlock.Block.Header.Time,
+		Signatures: signatures,
+	})
+}
+
+// GetValidatorSet returns the cached validator set, refetching it if it's outdated
+// or doesn't match the signatures, as the active set might have changed.
+func (w *BlocksWatcher) GetValidatorSet(
+	chain *types.Chain,
+	height int64,
+	signatures []types.CometBFTCommitSignature,
+) ([]string, error) {
+	w.Mutex.Lock()
+	validatorSet, found := w.ValidatorSets[chain.Name]
+	fetchedAt := w.ValidatorSetHeights[chain.Name]
+	w.Mutex.Unlock()
+
+	if found && height-fetchedAt < constants.ValidatorSetRefreshBlocks && matchesSignatures(validatorSet, signatures) {
+		return validatorSet, nil
+	}
+
+	validators, err := w.NodesManager.GetCometBFTValidators(chain, height)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorSet = make([]string, len(validators))
+	for index, validator := range validators {
+		validatorSet[index] = validator.Address
+	}
+
+	w.Mutex.Lock()
+	w.ValidatorSets[chain.Name] = validatorSet
+	w.ValidatorSetHeights[chain.Name] = height
+	w.Mutex.Unlock()
+
+	return validatorSet, nil
+}
+
+func matchesSignatures(validatorSet []string, signatures []types.CometBFTCommitSignature) bool {
+	if len(validatorSet) != len(signatures) {
+		return false
+	}
+
+	for index, signature := range signatures {
+		if signature.ValidatorAddress != "" && signature.ValidatorAddress != validatorSet[index] {
+			return false
+		}
+	}
+
+	return true
+}
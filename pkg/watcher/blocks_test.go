@@ -0,0 +1,138 @@
+package watcher
+
+import (
+	"encoding/json"
+	"main/assets"
+	converterPkg "main/pkg/converter"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func getBlocksWatcher(t *testing.T) (*BlocksWatcher, sqlmock.Sqlmock) {
+	t.Helper()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	database.SetClient(db)
+
+	watcher := NewBlocksWatcher(
+		types.UptimeConfig{BlocksWindow: 10},
+		logger,
+		database,
+		dataFetcher,
+		nodesManager,
+	)
+
+	return watcher, mock
+}
+
+func getNewBlockEvent(t *testing.T, height string, signatures []types.CometBFTCommitSignature) types.CometBFTEvent {
+	t.Helper()
+
+	var block types.CometBFTNewBlock
+	block.Block.LastCommit.Height = height
+	block.Block.LastCommit.Signatures = signatures
+
+	value, err := json.Marshal(block)
+	require.NoError(t, err)
+
+	event := types.CometBFTEvent{Query: "tm.event='NewBlock'"}
+	event.Data.Type = "tendermint/event/NewBlock"
+	event.Data.Value = value
+	return event
+}
+
+//nolint:paralleltest // disabled
+func TestBlocksWatcherProcessBlock(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.example.com/validators?height=99&page=1&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("cometbft-validators.json")))
+
+	watcher, mock := getBlocksWatcher(t)
+	chain := &types.Chain{Name: "chain"}
+
+	mock.ExpectQuery("SELECT host FROM rpc_nodes").
+		WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://rpc.example.com"))
+
+	// Absent signatures have no address, so it's taken from the validator set.
+	watcher.ProcessBlock(chain, getNewBlockEvent(t, "99", []types.CometBFTCommitSignature{
+		{BlockIDFlag: 2, ValidatorAddress: "AAAA"},
+		{BlockIDFlag: 1},
+	}))
+
+	// Validator set is cached, so it's not refetched.
+	watcher.ProcessBlock(chain, getNewBlockEvent(t, "100", []types.CometBFTCommitSignature{
+		{BlockIDFlag: 2, ValidatorAddress: "AAAA"},
+		{BlockIDFlag: 2, ValidatorAddress: "BBBB"},
+	}))
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	store := watcher.DataFetcher.UptimeStore
+	require.Equal(t, []types.ValidatorBlock{
+		{Height: 99, Signed: true},
+		{Height: 100, Signed: true},
+	}, store.GetValidatorBlocks("chain", "AAAA"))
+	require.Equal(t, []types.ValidatorBlock{
+		{Height: 99, Signed: false},
+		{Height: 100, Signed: true},
+	}, store.GetValidatorBlocks("chain", "BBBB"))
+}
+
+//nolint:paralleltest // disabled
+func TestBlocksWatcherSkipsMismatchingValidatorSet(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.example.com/validators?height=99&page=1&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("cometbft-validators.json")))
+
+	watcher, mock := getBlocksWatcher(t)
+	chain := &types.Chain{Name: "chain"}
+
+	mock.ExpectQuery("SELECT host FROM rpc_nodes").
+		WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://rpc.example.com"))
+
+	watcher.ProcessBlock(chain, getNewBlockEvent(t, "99", []types.CometBFTCommitSignature{
+		{BlockIDFlag: 2, ValidatorAddress: "AAAA"},
+		{BlockIDFlag: 2, ValidatorAddress: "BBBB"},
+		{BlockIDFlag: 2, ValidatorAddress: "CCCC"},
+	}))
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Zero(t, watcher.DataFetcher.UptimeStore.GetBlocksCount("chain"))
+}
+
+//nolint:paralleltest // disabled
+func TestBlocksWatcherSkipsFirstBlock(t *testing.T) {
+	watcher, mock := getBlocksWatcher(t)
+
+	watcher.ProcessBlock(&types.Chain{Name: "chain"}, getNewBlockEvent(t, "0", nil))
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Zero(t, watcher.DataFetcher.UptimeStore.GetBlocksCount("chain"))
+}
@@ -0,0 +1,87 @@
+package watcher
+
+import (
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	"main/pkg/types"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// DecentralizationWatcher periodically calculates the decentralization stats
+// of every chain, so the corresponding metrics are kept up to date.
+type DecentralizationWatcher struct {
+	Logger      zerolog.Logger
+	Config      types.DecentralizationConfig
+	Database    *databasePkg.Database
+	DataFetcher *datafetcher.DataFetcher
+
+	StopChannel chan bool
+}
+
+func NewDecentralizationWatcher(
+	config types.DecentralizationConfig,
+	logger *zerolog.Logger,
+	database *databasePkg.Database,
+	dataFetcher *datafetcher.DataFetcher,
+) *DecentralizationWatcher {
+	return &DecentralizationWatcher{
+		Logger:      logger.With().Str("component", "decentralization_watcher").Logger(),
+		Config:      config,
+		Database:    database,
+		DataFetcher: dataFetcher,
+		StopChannel: make(chan bool),
+	}
+}
+
+func (w *DecentralizationWatcher) Enabled() bool {
+	return w.Config.Enabled.Bool
+}
+
+func (w *DecentralizationWatcher) Start() {
+	ticker := time.NewTicker(w.Config.Interval)
+	defer ticker.Stop()
+
+	w.Tick()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.Tick()
+		case <-w.StopChannel:
+			w.Logger.Info().Msg("Shutting down...")
+			return
+		}
+	}
+}
+
+func (w *DecentralizationWatcher) Stop() {
+	w.StopChannel <- true
+}
+
+func (w *DecentralizationWatcher) Tick() {
+	chains, err := w.Database.GetAllChains()
+	if err != nil {
+		w.Logger.Error().Err(err).Msg("Error getting chains")
+		return
+	}
+
+	decentralization := w.DataFetcher.GetDecentralization(chains)
+
+	for chainName, chainDecentralization := range decentralization.Chains {
+		if chainDecentralization.Error != nil {
+			w.Logger.Warn().
+				Err(chainDecentralization.Error).
+				Str("chain", chainName).
+				Msg("Error calculating chain decentralization")
+			continue
+		}
+
+		w.Logger.Debug().
+			Str("chain", chainName).
+			Int("nakamoto_coefficient", chainDecentralization.Stats.NakamotoCoefficient33).
+			Float64("gini_coefficient", chainDecentralization.Stats.GiniCoefficient).
+			Msg("Calculated chain decentralization")
+	}
+}
@@ -0,0 +1,103 @@
+package watcher
+
+import (
+	"fmt"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	notifierPkg "main/pkg/notifier"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+
+	"github.com/rs/zerolog"
+)
+
+// DigestsWatcher sends the chats that enabled a digest a summary of their bound chains,
+// and optionally of their wallets, at the scheduled time.
+type DigestsWatcher struct {
+	Logger      zerolog.Logger
+	Config      types.DigestsConfig
+	Database    *databasePkg.Database
+	DataFetcher *datafetcher.DataFetcher
+	Notifier    *notifierPkg.Notifier
+	Time        timePkg.Time
+}
+
+func NewDigestsWatcher(
+	config types.DigestsConfig,
+	logger *zerolog.Logger,
+	database *databasePkg.Database,
+	dataFetcher *datafetcher.DataFetcher,
+	notifier *notifierPkg.Notifier,
+	timer timePkg.Time,
+) *DigestsWatcher {
+	return &DigestsWatcher{
+		Logger:      logger.With().Str("component", "digests_watcher").Logger(),
+		Config:      config,
+		Database:    database,
+		DataFetcher: dataFetcher,
+		Notifier:    notifier,
+		Time:        timer,
+	}
+}
+
+func (w *DigestsWatcher) Enabled() bool {
+	return w.Config.Enabled.Bool
+}
+
+func (w *DigestsWatcher) Tick() error {
+	subscriptions, err := w.Database.GetDigestSubscriptions()
+	if err != nil {
+		return fmt.Errorf("error getting digest subscriptions: %w", err)
+	}
+
+	now := w.Time.Now()
+
+	// Digests are sent one by one, as each of them queries all the chat chains.
+	for _, subscription := range subscriptions {
+		if !subscription.IsDue(now) {
+			continue
+		}
+
+		if err := w.ProcessSubscription(subscription); err != nil {
+			w.Logger.Error().
+				Err(err).
+				Str("reporter", subscription.Reporter).
+				Str("chat", subscription.ChatID).
+				Msg("Error sending digest")
+		}
+	}
+
+	return nil
+}
+
+func (w *DigestsWatcher) ProcessSubscription(subscription *types.DigestSubscription) error {
+	chainNames, err := w.Database.GetAllChainBinds(subscription.ChatID)
+	if err != nil {
+		return fmt.Errorf("error getting chain binds: %w", err)
+	}
+
+	now := w.Time.Now()
+
+	digest, snapshot, err := w.DataFetcher.GetDigest(subscription, chainNames, now)
+	if err != nil {
+		return fmt.Errorf("error getting digest: %w", err)
+	}
+
+	// Saving the send time first: the subscription is not due again until its next
+	// scheduled time, so a restart in between does not send this digest twice.
+	subscription.LastSentAt = now
+	subscription.Snapshot = snapshot
+
+	if err := w.Database.UpdateDigestSubscriptionSent(subscription); err != nil {
+		return fmt.Errorf("error saving digest: %w", err)
+	}
+
+	return w.Notifier.Send(
+		&types.NotificationRecipient{Reporter: subscription.Reporter, ChatID: subscription.ChatID},
+		&types.NotificationEvent{
+			Type:     types.NotificationTypeDigest,
+			Template: "digest",
+			Data:     digest,
+		},
+	)
+}
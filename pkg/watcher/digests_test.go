@@ -0,0 +1,296 @@
+package watcher
+
+import (
+	"encoding/json"
+	"errors"
+	"main/assets"
+	converterPkg "main/pkg/converter"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"main/pkg/tendermint"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/guregu/null/v5"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+var digestSubscriptionsColumns = []string{
+	"reporter",
+	"chat_id",
+	"frequency",
+	"time",
+	"timezone",
+	"balances_user_id",
+	"last_sent_at",
+	"snapshot",
+}
+
+// 2025-01-20 is a Monday.
+var digestNow = time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC)
+
+func getDigestsWatcher(t *testing.T, interacter *StubInteracter) (*DigestsWatcher, sqlmock.Sqlmock) {
+	t.Helper()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	database.SetClient(db)
+
+	watcher := NewDigestsWatcher(
+		types.DigestsConfig{},
+		logger,
+		database,
+		dataFetcher,
+		getNotifier(database, metricsManager, interacter),
+		&timePkg.StubTime{NowTime: digestNow},
+	)
+
+	return watcher, mock
+}
+
+// getPreviousDigestSnapshot returns a snapshot where one of the active validators
+// was not in the active set yet, and another one has left it since.
+func getPreviousDigestSnapshot(t *testing.T) (*types.DigestSnapshot, string) {
+	t.Helper()
+
+	var validators struct {
+		Validators []struct {
+			OperatorAddress string `json:"operator_address"`
+			Status          string `json:"status"`
+			Description     struct {
+				Moniker string `json:"moniker"`
+			} `json:"description"`
+		} `json:"validators"`
+	}
+
+	require.NoError(t, json.Unmarshal(assets.GetBytesOrPanic("validators.json"), &validators))
+
+	activeSet := map[string]string{"cosmosvaloper1gone": "Gone"}
+	joined := ""
+
+	for _, validator := range validators.Validators {
+		if validator.Status != "BOND_STATUS_BONDED" {
+			continue
+		}
+
+		if joined == "" {
+			joined = validator.Description.Moniker
+			continue
+		}
+
+		activeSet[validator.OperatorAddress] = validator.Description.Moniker
+	}
+
+	return &types.DigestSnapshot{
+		Chains: map[string]*types.ChainDigestSnapshot{
+			"chain": {
+				Supply:      null.FloatFrom(400000000),
+				BondedRatio: null.FloatFrom(0.5),
+				ActiveSet:   activeSet,
+				Prices:      map[string]float64{"ATOM": 7},
+			},
+		},
+		Wallets: map[string]map[string]float64{
+			"chain/cosmos1xxx": {"ATOM": 10},
+		},
+	}, joined
+}
+
+//nolint:paralleltest // disabled
+func TestDigestsWatcherErrorGettingSubscriptions(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getDigestsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT reporter, chat_id, frequency, time, timezone, balances_user_id, last_sent_at, snapshot FROM digest_subscriptions").
+		WillReturnError(errors.New("custom error"))
+
+	require.Error(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestDigestsWatcherNotDue(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getDigestsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT reporter, chat_id, frequency, time, timezone, balances_user_id, last_sent_at, snapshot FROM digest_subscriptions").
+		WillReturnRows(sqlmock.NewRows(digestSubscriptionsColumns).
+			AddRow("telegram", "1", "daily", 10*60, "UTC", nil, digestNow.Add(-12*time.Hour), nil).
+			AddRow("telegram", "2", "weekly", 9*60, "UTC", nil, digestNow, nil))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestDigestsWatcherErrorGettingChainBinds(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getDigestsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT reporter, chat_id, frequency, time, timezone, balances_user_id, last_sent_at, snapshot FROM digest_subscriptions").
+		WillReturnRows(sqlmock.NewRows(digestSubscriptionsColumns).
+			AddRow("telegram", "1", "daily", 9*60, "UTC", nil, digestNow.Add(-24*time.Hour), nil))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnError(errors.New("custom error"))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestDigestsWatcherErrorSaving(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getDigestsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT reporter, chat_id, frequency, time, timezone, balances_user_id, last_sent_at, snapshot FROM digest_subscriptions").
+		WillReturnRows(sqlmock.NewRows(digestSubscriptionsColumns).
+			AddRow("telegram", "1", "daily", 9*60, "UTC", nil, digestNow.Add(-24*time.Hour), nil))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}))
+
+	mock.ExpectExec("UPDATE digest_subscriptions").
+		WillReturnError(errors.New("custom error"))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestDigestsWatcherOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/gov/v1/proposals?proposal_status=PROPOSAL_STATUS_VOTING_PERIOD&pagination.limit=1000",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("proposals-active.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/bank/v1beta1/supply?pagination.limit=1000",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("supply.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/pool",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("pool.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/validators?pagination.limit=1000",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/simple/price?ids=cosmos&vs_currencies=usd",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("coingecko.json")))
+
+	interacter := &StubInteracter{}
+	watcher, mock := getDigestsWatcher(t, interacter)
+
+	previous, joined := getPreviousDigestSnapshot(t)
+	previousBytes, err := json.Marshal(previous)
+	require.NoError(t, err)
+
+	mock.ExpectQuery("SELECT reporter, chat_id, frequency, time, timezone, balances_user_id, last_sent_at, snapshot FROM digest_subscriptions").
+		WillReturnRows(sqlmock.NewRows(digestSubscriptionsColumns).
+			AddRow("telegram", "1", "weekly", 9*60, "UTC", "1", digestNow.AddDate(0, 0, -7), previousBytes))
+
+	mock.ExpectQuery("SELECT chain FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain"}).AddRow("chain"))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"))
+
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"chain",
+			"name",
+			"proposal_link_pattern",
+			"wallet_link_pattern",
+			"validator_link_pattern",
+			"main_link",
+			"tx_link_pattern",
+		}))
+
+	for range 4 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, "cosmos", false))
+
+	mock.ExpectQuery("SELECT chain, reporter, user_id, address, alias FROM wallet_links").
+		WithArgs("1", "telegram").
+		WillReturnError(errors.New("custom error"))
+
+	mock.ExpectExec("UPDATE digest_subscriptions").
+		WithArgs(digestNow, sqlmock.AnyArg(), "telegram", "1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	require.Len(t, interacter.Notifications["1"], 1)
+
+	event := interacter.Notifications["1"][0]
+	require.Equal(t, types.NotificationTypeDigest, event.Type)
+	require.Equal(t, "digest", event.Template)
+
+	digest, ok := event.Data.(*types.Digest)
+	require.True(t, ok)
+	require.Equal(t, digestNow.AddDate(0, 0, -7), digest.Since)
+	require.Len(t, digest.Chains, 1)
+	require.Error(t, digest.WalletsError)
+
+	chainDigest := digest.Chains[0]
+	require.Len(t, chainDigest.NewProposals, 1)
+	require.Equal(t, "987", chainDigest.NewProposals[0].ID)
+	require.Len(t, chainDigest.EndingProposals, 4)
+
+	require.NotNil(t, chainDigest.Supply)
+	require.Equal(t, "ATOM", chainDigest.Supply.Denom)
+	require.InDelta(t, 432307337.69057, chainDigest.SupplyChange.Current, 0.0001)
+	require.InDelta(t, 400000000, chainDigest.SupplyChange.Previous, 0.0001)
+	require.InDelta(t, 0.5422, chainDigest.BondedRatio.Current, 0.0001)
+	require.True(t, chainDigest.BondedRatio.HasPrevious)
+
+	require.Equal(t, []string{joined}, chainDigest.JoinedActiveSet)
+	require.Equal(t, []string{"Gone"}, chainDigest.LeftActiveSet)
+
+	require.Len(t, chainDigest.Prices, 1)
+	require.Equal(t, "ATOM", chainDigest.Prices[0].Denom)
+	require.InDelta(t, 7.13, chainDigest.Prices[0].Price.Current, 0.0001)
+	require.InDelta(t, 7, chainDigest.Prices[0].Price.Previous, 0.0001)
+
+	// The wallets failed to be fetched, so the next digest compares them with the previous values.
+	snapshot := digest.Subscription.Snapshot
+	require.Equal(t, previous.Wallets, snapshot.Wallets)
+	require.Equal(t, chainDigest.ActiveSetSize, len(snapshot.Chains["chain"].ActiveSet))
+	require.InDelta(t, 7.13, snapshot.Chains["chain"].Prices["ATOM"], 0.0001)
+}
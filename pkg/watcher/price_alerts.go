@@ -0,0 +1,119 @@
+package watcher
+
+import (
+	"fmt"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	notifierPkg "main/pkg/notifier"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+
+	"github.com/guregu/null/v5"
+	"github.com/rs/zerolog"
+)
+
+// PriceAlertsWatcher checks the armed price alerts against the denoms prices,
+// and sends the users a private message once the alert target is crossed.
+type PriceAlertsWatcher struct {
+	Logger      zerolog.Logger
+	Config      types.PriceAlertsConfig
+	Database    *databasePkg.Database
+	DataFetcher *datafetcher.DataFetcher
+	Notifier    *notifierPkg.Notifier
+	Time        timePkg.Time
+}
+
+func NewPriceAlertsWatcher(
+	config types.PriceAlertsConfig,
+	logger *zerolog.Logger,
+	database *databasePkg.Database,
+	dataFetcher *datafetcher.DataFetcher,
+	notifier *notifierPkg.Notifier,
+	timer timePkg.Time,
+) *PriceAlertsWatcher {
+	return &PriceAlertsWatcher{
+		Logger:      logger.With().Str("component", "price_alerts_watcher").Logger(),
+		Config:      config,
+		Database:    database,
+		DataFetcher: dataFetcher,
+		Notifier:    notifier,
+		Time:        timer,
+	}
+}
+
+func (w *PriceAlertsWatcher) Enabled() bool {
+	return w.Config.Enabled.Bool
+}
+
+func (w *PriceAlertsWatcher) Tick() error {
+	alerts, err := w.Database.GetArmedPriceAlerts()
+	if err != nil {
+		return fmt.Errorf("error getting price alerts: %w", err)
+	}
+
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	chainWithDenoms := make([]types.ChainWithDenom, 0, len(alerts))
+	seen := map[types.ChainWithDenom]bool{}
+
+	for _, alert := range alerts {
+		chainWithDenom := types.ChainWithDenom{Chain: alert.Chain, Denom: alert.Denom}
+		if !seen[chainWithDenom] {
+			seen[chainWithDenom] = true
+			chainWithDenoms = append(chainWithDenoms, chainWithDenom)
+		}
+	}
+
+	denoms, err := w.Database.FindDenoms(chainWithDenoms)
+	if err != nil {
+		return fmt.Errorf("error getting denoms: %w", err)
+	}
+
+	// Prices are cached by the data fetcher, so frequent checks do not hit the price sources.
+	prices := map[types.ChainWithDenom]*types.DenomPrice{}
+	for _, price := range w.DataFetcher.GetDenomsPrices(denoms, false) {
+		prices[types.ChainWithDenom{Chain: price.Denom.Chain, Denom: price.Denom.Denom}] = price
+	}
+
+	for _, alert := range alerts {
+		price, found := prices[types.ChainWithDenom{Chain: alert.Chain, Denom: alert.Denom}]
+		if !found || !price.Price.Valid || !alert.IsCrossed(price.Price.Float64) {
+			continue
+		}
+
+		alert.DenomInfo = price.Denom
+
+		if err := w.ProcessAlert(alert, price); err != nil {
+			w.Logger.Error().
+				Err(err).
+				Int64("id", alert.ID).
+				Str("reporter", alert.Reporter).
+				Str("user", alert.UserID).
+				Msg("Error sending price alert")
+		}
+	}
+
+	return nil
+}
+
+func (w *PriceAlertsWatcher) ProcessAlert(alert *types.PriceAlert, price *types.DenomPrice) error {
+	// Triggered alerts are not checked anymore until they are re-armed,
+	// so saving it first means the price crossing is reported only once.
+	alert.TriggeredAt = null.TimeFrom(w.Time.Now())
+
+	if err := w.Database.UpdatePriceAlertTriggered(alert); err != nil {
+		return fmt.Errorf("error saving price alert: %w", err)
+	}
+
+	return w.Notifier.Send(
+		&types.NotificationRecipient{Reporter: alert.Reporter, ChatID: alert.UserID},
+		&types.NotificationEvent{
+			Type:     types.NotificationTypePriceAlert,
+			Chain:    alert.Chain,
+			Template: "price_alert",
+			Data:     types.PriceAlertNotification{Alert: alert, Price: price},
+		},
+	)
+}
@@ -0,0 +1,230 @@
+package watcher
+
+import (
+	"errors"
+	"main/assets"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/guregu/null/v5"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+var priceAlertsColumns = []string{
+	"id",
+	"reporter",
+	"user_id",
+	"chain",
+	"denom",
+	"direction",
+	"target",
+	"triggered_at",
+	"created_at",
+}
+
+var priceAlertsNow = time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC)
+
+func getPriceAlertsWatcher(t *testing.T, interacter *StubInteracter) (*PriceAlertsWatcher, sqlmock.Sqlmock) {
+	t.Helper()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, nil, metricsManager, nil)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	database.SetClient(db)
+
+	watcher := NewPriceAlertsWatcher(
+		types.PriceAlertsConfig{},
+		logger,
+		database,
+		dataFetcher,
+		getNotifier(database, metricsManager, interacter),
+		&timePkg.StubTime{NowTime: priceAlertsNow},
+	)
+
+	return watcher, mock
+}
+
+func expectPriceAlertsDenoms(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, "cosmos", false))
+}
+
+func registerPriceAlertsCoingecko() {
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/simple/price?ids=cosmos&vs_currencies=usd",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("coingecko.json")))
+}
+
+//nolint:paralleltest // disabled
+func TestPriceAlertsWatcherErrorGettingAlerts(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getPriceAlertsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT id, reporter, user_id, chain, denom, direction, target, triggered_at, created_at FROM price_alerts").
+		WillReturnError(errors.New("custom error"))
+
+	require.Error(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestPriceAlertsWatcherNoAlerts(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getPriceAlertsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT id, reporter, user_id, chain, denom, direction, target, triggered_at, created_at FROM price_alerts").
+		WillReturnRows(sqlmock.NewRows(priceAlertsColumns))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestPriceAlertsWatcherErrorGettingDenoms(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getPriceAlertsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT id, reporter, user_id, chain, denom, direction, target, triggered_at, created_at FROM price_alerts").
+		WillReturnRows(sqlmock.NewRows(priceAlertsColumns).
+			AddRow(1, "telegram", "1", "chain", "uatom", "above", 5, nil, priceAlertsNow))
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnError(errors.New("custom error"))
+
+	require.Error(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestPriceAlertsWatcherNotCrossed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerPriceAlertsCoingecko()
+
+	interacter := &StubInteracter{}
+	watcher, mock := getPriceAlertsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT id, reporter, user_id, chain, denom, direction, target, triggered_at, created_at FROM price_alerts").
+		WillReturnRows(sqlmock.NewRows(priceAlertsColumns).
+			AddRow(1, "telegram", "1", "chain", "uatom", "above", 10, nil, priceAlertsNow).
+			AddRow(2, "telegram", "2", "chain", "uatom", "below", 5, nil, priceAlertsNow))
+
+	expectPriceAlertsDenoms(mock)
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestPriceAlertsWatcherPriceNotAvailable(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/simple/price?ids=cosmos&vs_currencies=usd",
+		httpmock.NewErrorResponder(errors.New("custom error")))
+
+	interacter := &StubInteracter{}
+	watcher, mock := getPriceAlertsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT id, reporter, user_id, chain, denom, direction, target, triggered_at, created_at FROM price_alerts").
+		WillReturnRows(sqlmock.NewRows(priceAlertsColumns).
+			AddRow(1, "telegram", "1", "chain", "uatom", "above", 5, nil, priceAlertsNow))
+
+	expectPriceAlertsDenoms(mock)
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestPriceAlertsWatcherErrorSaving(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerPriceAlertsCoingecko()
+
+	interacter := &StubInteracter{}
+	watcher, mock := getPriceAlertsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT id, reporter, user_id, chain, denom, direction, target, triggered_at, created_at FROM price_alerts").
+		WillReturnRows(sqlmock.NewRows(priceAlertsColumns).
+			AddRow(1, "telegram", "1", "chain", "uatom", "above", 5, nil, priceAlertsNow))
+
+	expectPriceAlertsDenoms(mock)
+
+	mock.ExpectExec("UPDATE price_alerts SET triggered_at").
+		WillReturnError(errors.New("custom error"))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestPriceAlertsWatcherOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerPriceAlertsCoingecko()
+
+	interacter := &StubInteracter{}
+	watcher, mock := getPriceAlertsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT id, reporter, user_id, chain, denom, direction, target, triggered_at, created_at FROM price_alerts").
+		WillReturnRows(sqlmock.NewRows(priceAlertsColumns).
+			AddRow(1, "telegram", "1", "chain", "uatom", "above", 5, nil, priceAlertsNow).
+			AddRow(2, "telegram", "2", "chain", "uatom", "below", 5, nil, priceAlertsNow).
+			AddRow(3, "telegram", "3", "chain", "uatom", "below", 7.13, nil, priceAlertsNow))
+
+	expectPriceAlertsDenoms(mock)
+
+	mock.ExpectExec("UPDATE price_alerts SET triggered_at").
+		WithArgs(null.TimeFrom(priceAlertsNow), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	mock.ExpectExec("UPDATE price_alerts SET triggered_at").
+		WithArgs(null.TimeFrom(priceAlertsNow), 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	require.Len(t, interacter.Notifications, 2)
+	require.Len(t, interacter.Notifications["1"], 1)
+	require.Len(t, interacter.Notifications["3"], 1)
+
+	event := interacter.Notifications["1"][0]
+	require.Equal(t, types.NotificationTypePriceAlert, event.Type)
+	require.Equal(t, "price_alert", event.Template)
+
+	notification, ok := event.Data.(types.PriceAlertNotification)
+	require.True(t, ok)
+	require.Equal(t, int64(1), notification.Alert.ID)
+	require.Equal(t, "ATOM", notification.Alert.GetDisplayDenom())
+	require.False(t, notification.Alert.IsArmed())
+	require.InDelta(t, 7.13, notification.Price.Price.Float64, 0.0001)
+}
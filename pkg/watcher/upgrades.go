@@ -0,0 +1,165 @@
+package watcher
+
+import (
+	"fmt"
+	"main/pkg/constants"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	notifierPkg "main/pkg/notifier"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// UpgradesWatcher checks the upcoming upgrades of every chain bound to a chat,
+// and notifies these chats when an upgrade is 24 hours, 1 hour and 10 minutes away.
+type UpgradesWatcher struct {
+	Logger      zerolog.Logger
+	Config      types.UpgradesConfig
+	Database    *databasePkg.Database
+	DataFetcher *datafetcher.DataFetcher
+	Notifier    *notifierPkg.Notifier
+	Time        timePkg.Time
+}
+
+func NewUpgradesWatcher(
+	config types.UpgradesConfig,
+	logger *zerolog.Logger,
+	database *databasePkg.Database,
+	dataFetcher *datafetcher.DataFetcher,
+	notifier *notifierPkg.Notifier,
+	timer timePkg.Time,
+) *UpgradesWatcher {
+	return &UpgradesWatcher{
+		Logger:      logger.With().Str("component", "upgrades_watcher").Logger(),
+		Config:      config,
+		Database:    database,
+		DataFetcher: dataFetcher,
+		Notifier:    notifier,
+		Time:        timer,
+	}
+}
+
+func (w *UpgradesWatcher) Enabled() bool {
+	return w.Config.Enabled.Bool
+}
+
+func (w *UpgradesWatcher) Tick() error {
+	binds, err := w.Database.GetAllChainBindsWithChats()
+	if err != nil {
+		return fmt.Errorf("error getting chain binds: %w", err)
+	}
+
+	bindsByChain := utils.GroupBy(binds, func(b *types.ChainBind) []string {
+		return []string{b.Chain}
+	})
+
+	chainNames := make([]string, 0, len(bindsByChain))
+	for chainName := range bindsByChain {
+		chainNames = append(chainNames, chainName)
+	}
+
+	if len(chainNames) == 0 {
+		w.Logger.Trace().Msg("No chains bound, not watching upgrades")
+		return nil
+	}
+
+	chains, err := w.Database.GetChainsByNames(chainNames)
+	if err != nil {
+		return fmt.Errorf("error getting chains: %w", err)
+	}
+
+	explorers, err := w.Database.GetExplorersByChains(chainNames)
+	if err != nil {
+		return fmt.Errorf("error getting explorers: %w", err)
+	}
+
+	var wg sync.WaitGroup
+
+	for _, chain := range chains {
+		wg.Add(1)
+		go func(chain *types.Chain) {
+			defer wg.Done()
+			w.ProcessChain(chain, explorers.GetExplorersByChain(chain.Name))
+		}(chain)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+func (w *UpgradesWatcher) ProcessChain(
+	chain *types.Chain,
+	explorers types.Explorers,
+) {
+	upgrades := w.DataFetcher.GetChainUpgrades(chain, false)
+	if upgrades.Error != nil {
+		w.Logger.Error().Err(upgrades.Error).Str("chain", chain.Name).Msg("Error getting chain upgrades")
+		return
+	}
+
+	for _, upgrade := range upgrades.Upgrades {
+		timeLeft := -w.Time.Since(upgrade.EstimatedTime)
+
+		threshold, found := GetUpgradeNotificationThreshold(timeLeft)
+		if !found {
+			continue
+		}
+
+		// Marking as sent before sending, so it's not sent twice
+		// if the app is restarted or there are multiple instances running.
+		inserted, err := w.Database.InsertUpgradeNotification(chain.Name, upgrade.Plan.Name, threshold.String())
+		if err != nil {
+			w.Logger.Error().Err(err).Str("chain", chain.Name).Msg("Error saving upgrade notification")
+			continue
+		}
+
+		if !inserted {
+			continue
+		}
+
+		w.Logger.Info().
+			Str("chain", chain.Name).
+			Str("upgrade", upgrade.Plan.Name).
+			Dur("time_left", timeLeft).
+			Msg("Notifying about upcoming upgrade")
+
+		w.Notifier.Publish(&types.NotificationEvent{
+			Type:     types.NotificationTypeUpgrade,
+			Chain:    chain.Name,
+			Template: "upgrade_notification",
+			Data: &types.UpgradeNotification{
+				Chain:     chain,
+				Explorers: explorers,
+				Upgrade:   upgrade,
+				TimeLeft:  timeLeft,
+			},
+		})
+	}
+}
+
+// GetUpgradeNotificationThreshold returns the closest notification threshold
+// the upgrade has already reached, like 1 hour if it's 50 minutes away.
+// Thresholds that were missed, like when the app was not running, are skipped.
+func GetUpgradeNotificationThreshold(timeLeft time.Duration) (time.Duration, bool) {
+	if timeLeft <= 0 {
+		return 0, false
+	}
+
+	var threshold time.Duration
+	found := false
+
+	for _, candidate := range constants.UpgradeNotificationThresholds {
+		if timeLeft <= candidate && (!found || candidate < threshold) {
+			threshold = candidate
+			found = true
+		}
+	}
+
+	return threshold, found
+}
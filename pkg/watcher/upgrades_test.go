@@ -0,0 +1,212 @@
+package watcher
+
+import (
+	"errors"
+	"main/assets"
+	converterPkg "main/pkg/converter"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"main/pkg/tendermint"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func getUpgradesWatcher(t *testing.T, interacter *StubInteracter) (*UpgradesWatcher, sqlmock.Sqlmock) {
+	t.Helper()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	database.SetClient(db)
+
+	// The v22 upgrade from the assets is estimated to happen at 2025-01-19 05:42:12 UTC.
+	now, err := time.Parse(time.RFC3339, "2025-01-19T05:00:00Z")
+	require.NoError(t, err)
+
+	watcher := NewUpgradesWatcher(
+		types.UpgradesConfig{},
+		logger,
+		database,
+		dataFetcher,
+		getNotifier(database, metricsManager, interacter),
+		&timePkg.StubTime{NowTime: now},
+	)
+
+	return watcher, mock
+}
+
+func expectChainBindsAndChains(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT chain, reporter, chat_id, chat_name FROM chain_binds").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "reporter", "chat_id", "chat_name"}).
+			AddRow("chain", "telegram", "1", "Chat 1").
+			AddRow("chain", "telegram", "2", "Chat 2"),
+		)
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"))
+
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
+		WillReturnRows(sqlmock.
+			NewRows([]string{
+				"chain",
+				"name",
+				"proposal_link_pattern",
+				"wallet_link_pattern",
+				"validator_link_pattern",
+				"main_link",
+				"tx_link_pattern",
+			}))
+
+	for range 3 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+}
+
+func registerUpgradeResponders() {
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/upgrade/v1beta1/current_plan",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-current-plan.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("blocks-latest.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/base/tendermint/v1beta1/blocks/24026995",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("block-previous.json")))
+}
+
+//nolint:paralleltest // disabled
+func TestUpgradesWatcherErrorFetchingChainBinds(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getUpgradesWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT chain, reporter, chat_id, chat_name FROM chain_binds").
+		WillReturnError(errors.New("custom error"))
+
+	require.Error(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestUpgradesWatcherNoChainBinds(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getUpgradesWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT chain, reporter, chat_id, chat_name FROM chain_binds").
+		WillReturnRows(sqlmock.NewRows([]string{"chain", "reporter", "chat_id", "chat_name"}))
+
+	require.NoError(t, watcher.Tick())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestUpgradesWatcherNotifies(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerUpgradeResponders()
+
+	interacter := &StubInteracter{}
+	watcher, mock := getUpgradesWatcher(t, interacter)
+
+	expectChainBindsAndChains(mock)
+
+	mock.ExpectExec("INSERT INTO upgrade_notifications").
+		WithArgs("chain", "v22", "1h0m0s").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery("SELECT reporter, chat_id FROM chain_binds WHERE chain = \\$1").
+		WithArgs("chain").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"reporter", "chat_id"}).
+			AddRow("telegram", "1").
+			AddRow("telegram", "2"),
+		)
+
+	for range 2 {
+		expectNotificationSettings(mock)
+	}
+
+	require.NoError(t, watcher.Tick())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Len(t, interacter.Notifications, 2)
+	require.Len(t, interacter.Notifications["1"], 1)
+	require.Len(t, interacter.Notifications["2"], 1)
+	require.Equal(t, types.NotificationTypeUpgrade, interacter.Notifications["1"][0].Type)
+
+	notification, ok := interacter.Notifications["1"][0].Data.(*types.UpgradeNotification)
+	require.True(t, ok)
+	require.Equal(t, "v22", notification.Upgrade.Plan.Name)
+	require.Equal(t, 42*time.Minute+12*time.Second, notification.TimeLeft.Truncate(time.Second))
+}
+
+//nolint:paralleltest // disabled
+func TestUpgradesWatcherAlreadyNotified(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerUpgradeResponders()
+
+	interacter := &StubInteracter{}
+	watcher, mock := getUpgradesWatcher(t, interacter)
+
+	expectChainBindsAndChains(mock)
+
+	mock.ExpectExec("INSERT INTO upgrade_notifications").
+		WithArgs("chain", "v22", "1h0m0s").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.NoError(t, watcher.Tick())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+func TestGetUpgradeNotificationThreshold(t *testing.T) {
+	t.Parallel()
+
+	_, found := GetUpgradeNotificationThreshold(-time.Minute)
+	require.False(t, found)
+
+	_, found = GetUpgradeNotificationThreshold(48 * time.Hour)
+	require.False(t, found)
+
+	threshold, found := GetUpgradeNotificationThreshold(23 * time.Hour)
+	require.True(t, found)
+	require.Equal(t, 24*time.Hour, threshold)
+
+	threshold, found = GetUpgradeNotificationThreshold(time.Hour)
+	require.True(t, found)
+	require.Equal(t, time.Hour, threshold)
+
+	threshold, found = GetUpgradeNotificationThreshold(5 * time.Minute)
+	require.True(t, found)
+	require.Equal(t, 10*time.Minute, threshold)
+}
@@ -0,0 +1,195 @@
+package watcher
+
+import (
+	"fmt"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	notifierPkg "main/pkg/notifier"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// WalletsWatcher polls the latest block of every chain having linked wallets,
+// and notifies wallet owners about the new txs involving their wallets.
+type WalletsWatcher struct {
+	Logger       zerolog.Logger
+	Config       types.WatcherConfig
+	Database     *databasePkg.Database
+	DataFetcher  *datafetcher.DataFetcher
+	NodesManager *tendermint.NodeManager
+	Notifier     *notifierPkg.Notifier
+}
+
+func NewWalletsWatcher(
+	config types.WatcherConfig,
+	logger *zerolog.Logger,
+	database *databasePkg.Database,
+	dataFetcher *datafetcher.DataFetcher,
+	nodesManager *tendermint.NodeManager,
+	notifier *notifierPkg.Notifier,
+) *WalletsWatcher {
+	return &WalletsWatcher{
+		Logger:       logger.With().Str("component", "wallets_watcher").Logger(),
+		Config:       config,
+		Database:     database,
+		DataFetcher:  dataFetcher,
+		NodesManager: nodesManager,
+		Notifier:     notifier,
+	}
+}
+
+func (w *WalletsWatcher) Enabled() bool {
+	return w.Config.Enabled.Bool
+}
+
+func (w *WalletsWatcher) Tick() error {
+	wallets, err := w.Database.GetAllWalletLinks()
+	if err != nil {
+		return fmt.Errorf("error getting wallet links: %w", err)
+	}
+
+	walletsByChain := utils.GroupBy(wallets, func(w *types.WalletLink) []string {
+		return []string{w.Chain}
+	})
+
+	chainNames := make([]string, 0, len(walletsByChain))
+	for chainName := range walletsByChain {
+		chainNames = append(chainNames, chainName)
+	}
+
+	if len(chainNames) == 0 {
+		w.Logger.Trace().Msg("No wallets linked, not watching any chains")
+		return nil
+	}
+
+	chains, err := w.Database.GetChainsByNames(chainNames)
+	if err != nil {
+		return fmt.Errorf("error getting chains: %w", err)
+	}
+
+	explorers, err := w.Database.GetExplorersByChains(chainNames)
+	if err != nil {
+		return fmt.Errorf("error getting explorers: %w", err)
+	}
+
+	// Last block height processed per chain, txs in it and before it
+	// were already notified about. Kept in the database, as the job might be
+	// taken over by another app instance.
+	lastHeights, err := w.Database.GetWalletsWatcherHeights()
+	if err != nil {
+		return fmt.Errorf("error getting last processed heights: %w", err)
+	}
+
+	var wg sync.WaitGroup
+
+	for _, chain := range chains {
+		wg.Add(1)
+		go func(chain *types.Chain) {
+			defer wg.Done()
+			w.ProcessChain(chain, explorers, walletsByChain[chain.Name], lastHeights)
+		}(chain)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+func (w *WalletsWatcher) ProcessChain(
+	chain *types.Chain,
+	explorers types.Explorers,
+	wallets []*types.WalletLink,
+	lastHeights map[string]int64,
+) {
+	block, err := w.NodesManager.GetLatestBlock(chain)
+	if err != nil {
+		w.Logger.Error().Err(err).Str("chain", chain.Name).Msg("Error getting latest block")
+		return
+	}
+
+	height := block.Block.Header.Height //nolint:staticcheck
+
+	lastHeight, found := lastHeights[chain.Name]
+
+	// not notifying about txs that happened before the app was started
+	if !found {
+		w.Logger.Info().
+			Str("chain", chain.Name).
+			Int64("height", height).
+			Msg("Starting watching chain")
+		_ = w.SetLastHeight(chain, height)
+		return
+	}
+
+	if height <= lastHeight {
+		w.Logger.Trace().
+			Str("chain", chain.Name).
+			Int64("height", height).
+			Msg("No new blocks")
+		return
+	}
+
+	notifications, err := w.DataFetcher.GetWalletsNewTxs(chain, explorers, wallets, lastHeight, height)
+	if err != nil {
+		// not updating the last height, so these blocks are processed on the next tick
+		w.Logger.Error().
+			Err(err).
+			Str("chain", chain.Name).
+			Int64("from", lastHeight).
+			Int64("to", height).
+			Msg("Error getting new wallets txs")
+		return
+	}
+
+	// not notifying if the height was not saved, otherwise these txs would be
+	// notified about again on the next tick
+	if err := w.SetLastHeight(chain, height); err != nil {
+		return
+	}
+
+	w.Logger.Debug().
+		Str("chain", chain.Name).
+		Int64("from", lastHeight).
+		Int64("to", height).
+		Int("notifications", len(notifications)).
+		Msg("Processed new blocks")
+
+	for _, notification := range notifications {
+		w.Notify(notification)
+	}
+}
+
+func (w *WalletsWatcher) SetLastHeight(chain *types.Chain, height int64) error {
+	if err := w.Database.SetWalletsWatcherHeight(chain.Name, height); err != nil {
+		w.Logger.Error().
+			Err(err).
+			Str("chain", chain.Name).
+			Int64("height", height).
+			Msg("Error saving last processed height")
+		return err
+	}
+
+	return nil
+}
+
+// Notify sends the notification only to the wallet link owner, as other users
+// having this wallet linked might have a different notification threshold.
+func (w *WalletsWatcher) Notify(notification *types.WalletTxNotification) {
+	w.Notifier.PublishTo(
+		[]*types.NotificationRecipient{{
+			Reporter: notification.Wallet.Reporter,
+			ChatID:   notification.Wallet.UserID,
+		}},
+		&types.NotificationEvent{
+			Type:          types.NotificationTypeTransfer,
+			Chain:         notification.Chain.Name,
+			WalletAddress: notification.Wallet.Address,
+			Template:      "wallet_tx",
+			Data:          notification,
+		},
+	)
+}
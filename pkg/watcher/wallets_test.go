@@ -0,0 +1,380 @@
+package watcher
+
+import (
+	"errors"
+	"main/assets"
+	converterPkg "main/pkg/converter"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	interacterPkg "main/pkg/interacter"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	notifierPkg "main/pkg/notifier"
+	"main/pkg/tendermint"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+// StubInteracter records the notifications sent to each chat. The rendered text
+// is the event index, so the event can be found by the text sent.
+type StubInteracter struct {
+	Events        []*types.NotificationEvent
+	Notifications map[string][]*types.NotificationEvent
+	Mutex         sync.Mutex
+}
+
+func (i *StubInteracter) Name() string  { return "telegram" }
+func (i *StubInteracter) Enabled() bool { return true }
+func (i *StubInteracter) Init()         {}
+func (i *StubInteracter) Start()        {}
+func (i *StubInteracter) Stop()         {}
+
+func (i *StubInteracter) RenderNotification(event *types.NotificationEvent) (string, error) {
+	i.Mutex.Lock()
+	defer i.Mutex.Unlock()
+
+	i.Events = append(i.Events, event)
+	return strconv.Itoa(len(i.Events) - 1), nil
+}
+
+func (i *StubInteracter) RenderQueuedNotifications(notifications []*types.QueuedNotification) (string, error) {
+	return notifications[0].Text, nil
+}
+
+func (i *StubInteracter) SendNotification(chatID string, text string) error {
+	i.Mutex.Lock()
+	defer i.Mutex.Unlock()
+
+	index, err := strconv.Atoi(text)
+	if err != nil {
+		return err
+	}
+
+	if i.Notifications == nil {
+		i.Notifications = map[string][]*types.NotificationEvent{}
+	}
+
+	i.Notifications[chatID] = append(i.Notifications[chatID], i.Events[index])
+	return nil
+}
+
+func getNotifier(database *databasePkg.Database, metricsManager *metrics.Manager, interacter *StubInteracter) *notifierPkg.Notifier {
+	return notifierPkg.NewNotifier(
+		types.NotifierConfig{},
+		loggerPkg.GetNopLogger(),
+		database,
+		metricsManager,
+		[]interacterPkg.Interacter{interacter},
+		&timePkg.SystemTime{},
+	)
+}
+
+// expectNotificationSettings expects the default settings to be used for a recipient.
+func expectNotificationSettings(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT muted_types, muted_chains, mode, timezone, quiet_hours_start, quiet_hours_end FROM notification_settings").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"muted_types",
+			"muted_chains",
+			"mode",
+			"timezone",
+			"quiet_hours_start",
+			"quiet_hours_end",
+		}))
+}
+
+func getWalletsWatcher(t *testing.T, interacter *StubInteracter) (*WalletsWatcher, sqlmock.Sqlmock) {
+	t.Helper()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	database.SetClient(db)
+
+	watcher := NewWalletsWatcher(
+		types.WatcherConfig{},
+		logger,
+		database,
+		dataFetcher,
+		nodesManager,
+		getNotifier(database, metricsManager, interacter),
+	)
+
+	return watcher, mock
+}
+
+// expectWalletsAndChains expects the wallets, chains and explorers to be fetched, and
+// the given last processed height to be returned, or none if it is zero.
+func expectWalletsAndChains(mock sqlmock.Sqlmock, lastHeight int64) {
+	mock.ExpectQuery("SELECT chain, reporter, user_id, address, alias, notify_threshold FROM wallet_links").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "reporter", "user_id", "address", "alias", "notify_threshold"}).
+			AddRow("chain", "telegram", "1", "cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2", "Wallet", 2),
+		)
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"))
+
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
+		WillReturnRows(sqlmock.
+			NewRows([]string{
+				"chain",
+				"name",
+				"proposal_link_pattern",
+				"wallet_link_pattern",
+				"validator_link_pattern",
+				"main_link",
+				"tx_link_pattern",
+			}))
+
+	heights := sqlmock.NewRows([]string{"chain", "height"})
+	if lastHeight != 0 {
+		heights.AddRow("chain", lastHeight)
+	}
+
+	mock.ExpectQuery("SELECT chain, height FROM wallets_watcher_heights").
+		WillReturnRows(heights)
+
+	mock.ExpectQuery("SELECT host FROM lcd").
+		WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+}
+
+//nolint:paralleltest // disabled
+func TestWalletsWatcherErrorFetchingWallets(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getWalletsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT chain, reporter, user_id, address, alias, notify_threshold FROM wallet_links").
+		WillReturnError(errors.New("custom error"))
+
+	require.Error(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestWalletsWatcherErrorFetchingLastHeights(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getWalletsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT chain, reporter, user_id, address, alias, notify_threshold FROM wallet_links").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "reporter", "user_id", "address", "alias", "notify_threshold"}).
+			AddRow("chain", "telegram", "1", "cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2", "Wallet", 2),
+		)
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"))
+
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
+		WillReturnRows(sqlmock.
+			NewRows([]string{
+				"chain",
+				"name",
+				"proposal_link_pattern",
+				"wallet_link_pattern",
+				"validator_link_pattern",
+				"main_link",
+				"tx_link_pattern",
+			}))
+
+	mock.ExpectQuery("SELECT chain, height FROM wallets_watcher_heights").
+		WillReturnError(errors.New("custom error"))
+
+	require.Error(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestWalletsWatcherFirstTick(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("blocks-latest.json")))
+
+	interacter := &StubInteracter{}
+	watcher, mock := getWalletsWatcher(t, interacter)
+
+	expectWalletsAndChains(mock, 0)
+
+	mock.ExpectExec("INSERT INTO wallets_watcher_heights").
+		WithArgs("chain", int64(24027995)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(t, watcher.Tick())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestWalletsWatcherFailedToFetchTxs(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("blocks-latest.json")))
+
+	interacter := &StubInteracter{}
+	watcher, mock := getWalletsWatcher(t, interacter)
+
+	expectWalletsAndChains(mock, 24027990)
+
+	for range 2 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	// not expecting the height to be saved, so these blocks are processed on the next tick
+	require.NoError(t, watcher.Tick())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestWalletsWatcherNotifies(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("blocks-latest.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/tx/v1beta1/txs?query=message.sender%3D%27cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2%27+AND+tx.height%3E%3D24027991+AND+tx.height%3C%3D24027995&order_by=ORDER_BY_DESC&limit=100&page=1",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("txs-sender.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/tx/v1beta1/txs?query=transfer.recipient%3D%27cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2%27+AND+tx.height%3E%3D24027991+AND+tx.height%3C%3D24027995&order_by=ORDER_BY_DESC&limit=100&page=1",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("txs-recipient.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/simple/price?ids=cosmos&vs_currencies=usd",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("coingecko.json")))
+
+	interacter := &StubInteracter{}
+	watcher, mock := getWalletsWatcher(t, interacter)
+
+	expectWalletsAndChains(mock, 24027990)
+
+	for range 2 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, "cosmos", false),
+		)
+
+	mock.ExpectExec("INSERT INTO wallets_watcher_heights").
+		WithArgs("chain", int64(24027995)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	for range 3 {
+		expectNotificationSettings(mock)
+	}
+
+	require.NoError(t, watcher.Tick())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// a failed vote, a contract execution and a send below threshold are not notified about
+	notifications := utils.Map(interacter.Notifications["1"], func(e *types.NotificationEvent) *types.WalletTxNotification {
+		require.Equal(t, types.NotificationTypeTransfer, e.Type)
+		notification, ok := e.Data.(*types.WalletTxNotification)
+		require.True(t, ok)
+		return notification
+	})
+	hashes := utils.Map(notifications, func(n *types.WalletTxNotification) string {
+		return n.Tx.Hash
+	})
+	require.Equal(t, []string{"BBBB", "DDDD", "FFFF"}, hashes)
+
+	// claiming rewards via authz does not move tokens from the wallet
+	execMessages := notifications[1].Tx.Messages[0].Messages
+	require.Len(t, execMessages, 1)
+	require.Equal(t, types.TxMessageTypeDelegate, execMessages[0].Type)
+}
+
+//nolint:paralleltest // disabled
+func TestWalletsWatcherFailedToSaveHeight(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("blocks-latest.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/tx/v1beta1/txs?query=message.sender%3D%27cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2%27+AND+tx.height%3E%3D24027991+AND+tx.height%3C%3D24027995&order_by=ORDER_BY_DESC&limit=100&page=1",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("txs-sender.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/tx/v1beta1/txs?query=transfer.recipient%3D%27cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2%27+AND+tx.height%3E%3D24027991+AND+tx.height%3C%3D24027995&order_by=ORDER_BY_DESC&limit=100&page=1",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("txs-recipient.json")))
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.coingecko.com/api/v3/simple/price?ids=cosmos&vs_currencies=usd",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("coingecko.json")))
+
+	interacter := &StubInteracter{}
+	watcher, mock := getWalletsWatcher(t, interacter)
+
+	expectWalletsAndChains(mock, 24027990)
+
+	for range 2 {
+		mock.ExpectQuery("SELECT host FROM lcd").
+			WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+	}
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, "cosmos", false),
+		)
+
+	mock.ExpectExec("INSERT INTO wallets_watcher_heights").
+		WithArgs("chain", int64(24027995)).
+		WillReturnError(errors.New("custom error"))
+
+	// the txs would be notified about again on the next tick, so not notifying now
+	require.NoError(t, watcher.Tick())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
@@ -0,0 +1,161 @@
+package watcher
+
+import (
+	"fmt"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	notifierPkg "main/pkg/notifier"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"main/pkg/utils"
+
+	"cosmossdk.io/math"
+	"github.com/rs/zerolog"
+)
+
+// WhaleAlertsWatcher takes snapshots of the validators tokens of the chains chats
+// have whale alerts enabled on, and notifies the chats about the changes
+// since the previous snapshot that are over their thresholds.
+type WhaleAlertsWatcher struct {
+	Logger      zerolog.Logger
+	Config      types.WhaleAlertsConfig
+	Database    *databasePkg.Database
+	DataFetcher *datafetcher.DataFetcher
+	Notifier    *notifierPkg.Notifier
+	Time        timePkg.Time
+}
+
+func NewWhaleAlertsWatcher(
+	config types.WhaleAlertsConfig,
+	logger *zerolog.Logger,
+	database *databasePkg.Database,
+	dataFetcher *datafetcher.DataFetcher,
+	notifier *notifierPkg.Notifier,
+	timer timePkg.Time,
+) *WhaleAlertsWatcher {
+	return &WhaleAlertsWatcher{
+		Logger:      logger.With().Str("component", "whale_alerts_watcher").Logger(),
+		Config:      config,
+		Database:    database,
+		DataFetcher: dataFetcher,
+		Notifier:    notifier,
+		Time:        timer,
+	}
+}
+
+func (w *WhaleAlertsWatcher) Enabled() bool {
+	return w.Config.Enabled.Bool
+}
+
+func (w *WhaleAlertsWatcher) Tick() error {
+	subscriptions, err := w.Database.GetWhaleAlertSubscriptions()
+	if err != nil {
+		return fmt.Errorf("error getting whale alert subscriptions: %w", err)
+	}
+
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	subscriptionsByChain := utils.GroupBy(subscriptions, func(s *types.WhaleAlertSubscription) []string {
+		return []string{s.Chain}
+	})
+
+	chainNames := make([]string, 0, len(subscriptionsByChain))
+	for chainName := range subscriptionsByChain {
+		chainNames = append(chainNames, chainName)
+	}
+
+	chains, err := w.Database.GetChainsByNames(chainNames)
+	if err != nil {
+		return fmt.Errorf("error getting chains: %w", err)
+	}
+
+	explorers, err := w.Database.GetExplorersByChains(chainNames)
+	if err != nil {
+		return fmt.Errorf("error getting explorers: %w", err)
+	}
+
+	for _, chain := range chains {
+		err := w.ProcessChain(
+			chain,
+			explorers.GetExplorersByChain(chain.Name),
+			subscriptionsByChain[chain.Name],
+		)
+		if err != nil {
+			w.Logger.Error().
+				Err(err).
+				Str("chain", chain.Name).
+				Msg("Error processing whale alerts")
+		}
+	}
+
+	return nil
+}
+
+func (w *WhaleAlertsWatcher) ProcessChain(
+	chain *types.Chain,
+	explorers types.Explorers,
+	subscriptions []*types.WhaleAlertSubscription,
+) error {
+	previous, err := w.Database.GetValidatorsSnapshot(chain.Name)
+	if err != nil {
+		return fmt.Errorf("error getting validators snapshot: %w", err)
+	}
+
+	now := w.Time.Now()
+
+	// A snapshot taken long ago, like before the alerts were disabled for a while
+	// or before this replica took the job over, is not compared with, as the changes
+	// it shows are not recent anymore.
+	if previous != nil && now.Sub(previous.CreatedAt) > 2*w.Config.Interval {
+		w.Logger.Debug().
+			Str("chain", chain.Name).
+			Time("created_at", previous.CreatedAt).
+			Msg("Validators snapshot is outdated, not comparing with it")
+		previous = nil
+	}
+
+	var previousTokens map[string]math.Int
+	if previous != nil {
+		previousTokens = previous.Tokens
+	}
+
+	changes, current, err := w.DataFetcher.GetValidatorsTokensChanges(chain, previousTokens)
+	if err != nil {
+		return fmt.Errorf("error getting validators: %w", err)
+	}
+
+	snapshot := &types.ValidatorsSnapshot{Chain: chain.Name, Tokens: current, CreatedAt: now}
+	if err := w.Database.UpsertValidatorsSnapshot(snapshot); err != nil {
+		return fmt.Errorf("error saving validators snapshot: %w", err)
+	}
+
+	if previous == nil {
+		return nil
+	}
+
+	for _, subscription := range subscriptions {
+		matched := utils.Filter(changes, subscription.Matches)
+		if len(matched) == 0 {
+			continue
+		}
+
+		w.Notifier.PublishTo(
+			[]*types.NotificationRecipient{{Reporter: subscription.Reporter, ChatID: subscription.ChatID}},
+			&types.NotificationEvent{
+				Type:     types.NotificationTypeWhale,
+				Chain:    chain.Name,
+				Template: "whale_alert",
+				Data: &types.WhaleAlert{
+					Chain:     chain,
+					Explorers: explorers,
+					Since:     previous.CreatedAt,
+					Changes:   matched,
+				},
+			},
+		)
+	}
+
+	return nil
+}
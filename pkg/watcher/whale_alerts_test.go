@@ -0,0 +1,346 @@
+package watcher
+
+import (
+	"encoding/json"
+	"errors"
+	"main/assets"
+	converterPkg "main/pkg/converter"
+	datafetcher "main/pkg/data_fetcher"
+	databasePkg "main/pkg/database"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"main/pkg/tendermint"
+	timePkg "main/pkg/time"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+var whaleAlertsColumns = []string{
+	"reporter",
+	"chat_id",
+	"chain",
+	"percent_threshold",
+	"tokens_threshold",
+}
+
+var whaleAlertsNow = time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC)
+
+func getWhaleAlertsWatcher(t *testing.T, interacter *StubInteracter) (*WhaleAlertsWatcher, sqlmock.Sqlmock) {
+	t.Helper()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(logger, types.MetricsConfig{})
+	database := databasePkg.NewDatabase(logger, types.DatabaseConfig{})
+	converter := converterPkg.NewConverter()
+	nodesManager := tendermint.NewNodeManager(logger, types.PaginationConfig{PageSize: 1000, MaxPages: 100}, database, converter, metricsManager)
+	dataFetcher := datafetcher.NewDataFetcher(logger, database, converter, metricsManager, nodesManager)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	database.SetClient(db)
+
+	watcher := NewWhaleAlertsWatcher(
+		types.WhaleAlertsConfig{Interval: 5 * time.Minute},
+		logger,
+		database,
+		dataFetcher,
+		getNotifier(database, metricsManager, interacter),
+		&timePkg.StubTime{NowTime: whaleAlertsNow},
+	)
+
+	return watcher, mock
+}
+
+// getPreviousValidatorsTokens returns the validators tokens where the first validator
+// had 10% less tokens than now, and the second one had a bit more.
+func getPreviousValidatorsTokens(t *testing.T) (map[string]math.Int, string) {
+	t.Helper()
+
+	var validators struct {
+		Validators []struct {
+			OperatorAddress string   `json:"operator_address"`
+			Tokens          math.Int `json:"tokens"`
+		} `json:"validators"`
+	}
+
+	require.NoError(t, json.Unmarshal(assets.GetBytesOrPanic("validators.json"), &validators))
+
+	tokens := make(map[string]math.Int, len(validators.Validators))
+	for index, validator := range validators.Validators {
+		switch index {
+		case 0:
+			tokens[validator.OperatorAddress] = validator.Tokens.MulRaw(9).QuoRaw(10)
+		case 1:
+			tokens[validator.OperatorAddress] = validator.Tokens.AddRaw(1)
+		default:
+			tokens[validator.OperatorAddress] = validator.Tokens
+		}
+	}
+
+	return tokens, validators.Validators[0].OperatorAddress
+}
+
+func expectWhaleAlertsChains(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"name", "pretty_name", "base_denom", "bech32_validator_prefix", "bech32_account_prefix", "coin_type", "mint_module"}).
+			AddRow("chain", "Chain", "uatom", "cosmosvaloper", "cosmos", 118, "standard"))
+
+	mock.ExpectQuery("SELECT chain, name, proposal_link_pattern, wallet_link_pattern, validator_link_pattern, main_link, tx_link_pattern FROM explorers").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"chain",
+			"name",
+			"proposal_link_pattern",
+			"wallet_link_pattern",
+			"validator_link_pattern",
+			"main_link",
+			"tx_link_pattern",
+		}))
+}
+
+func registerWhaleAlertsValidators() {
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/validators?pagination.limit=1000",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators.json")))
+}
+
+//nolint:paralleltest // disabled
+func TestWhaleAlertsWatcherErrorGettingSubscriptions(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getWhaleAlertsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT w.reporter, w.chat_id, w.chain, w.percent_threshold, w.tokens_threshold FROM whale_alerts").
+		WillReturnError(errors.New("custom error"))
+
+	require.Error(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestWhaleAlertsWatcherNoSubscriptions(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getWhaleAlertsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT w.reporter, w.chat_id, w.chain, w.percent_threshold, w.tokens_threshold FROM whale_alerts").
+		WillReturnRows(sqlmock.NewRows(whaleAlertsColumns))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestWhaleAlertsWatcherErrorGettingChains(t *testing.T) {
+	interacter := &StubInteracter{}
+	watcher, mock := getWhaleAlertsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT w.reporter, w.chat_id, w.chain, w.percent_threshold, w.tokens_threshold FROM whale_alerts").
+		WillReturnRows(sqlmock.NewRows(whaleAlertsColumns).AddRow("telegram", "1", "chain", 10, nil))
+
+	mock.ExpectQuery("SELECT name, pretty_name, base_denom, bech32_validator_prefix, bech32_account_prefix, coin_type, mint_module FROM chains").
+		WillReturnError(errors.New("custom error"))
+
+	require.Error(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestWhaleAlertsWatcherErrorGettingValidators(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/cosmos/staking/v1beta1/validators?pagination.limit=1000",
+		httpmock.NewErrorResponder(errors.New("custom error")))
+
+	interacter := &StubInteracter{}
+	watcher, mock := getWhaleAlertsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT w.reporter, w.chat_id, w.chain, w.percent_threshold, w.tokens_threshold FROM whale_alerts").
+		WillReturnRows(sqlmock.NewRows(whaleAlertsColumns).AddRow("telegram", "1", "chain", 10, nil))
+
+	expectWhaleAlertsChains(mock)
+
+	mock.ExpectQuery("SELECT tokens, created_at FROM validators_snapshots").
+		WillReturnRows(sqlmock.NewRows([]string{"tokens", "created_at"}))
+
+	mock.ExpectQuery("SELECT host FROM lcd").
+		WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestWhaleAlertsWatcherFirstSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerWhaleAlertsValidators()
+
+	interacter := &StubInteracter{}
+	watcher, mock := getWhaleAlertsWatcher(t, interacter)
+
+	mock.ExpectQuery("SELECT w.reporter, w.chat_id, w.chain, w.percent_threshold, w.tokens_threshold FROM whale_alerts").
+		WillReturnRows(sqlmock.NewRows(whaleAlertsColumns).AddRow("telegram", "1", "chain", 10, nil))
+
+	expectWhaleAlertsChains(mock)
+
+	mock.ExpectQuery("SELECT tokens, created_at FROM validators_snapshots").
+		WillReturnRows(sqlmock.NewRows([]string{"tokens", "created_at"}))
+
+	mock.ExpectQuery("SELECT host FROM lcd").
+		WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+
+	mock.ExpectExec("INSERT INTO validators_snapshots").
+		WithArgs("chain", sqlmock.AnyArg(), whaleAlertsNow).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestWhaleAlertsWatcherOutdatedSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerWhaleAlertsValidators()
+
+	interacter := &StubInteracter{}
+	watcher, mock := getWhaleAlertsWatcher(t, interacter)
+
+	previous, _ := getPreviousValidatorsTokens(t)
+	previousBytes, err := json.Marshal(previous)
+	require.NoError(t, err)
+
+	mock.ExpectQuery("SELECT w.reporter, w.chat_id, w.chain, w.percent_threshold, w.tokens_threshold FROM whale_alerts").
+		WillReturnRows(sqlmock.NewRows(whaleAlertsColumns).AddRow("telegram", "1", "chain", 10, nil))
+
+	expectWhaleAlertsChains(mock)
+
+	mock.ExpectQuery("SELECT tokens, created_at FROM validators_snapshots").
+		WillReturnRows(sqlmock.NewRows([]string{"tokens", "created_at"}).
+			AddRow(previousBytes, whaleAlertsNow.Add(-time.Hour)))
+
+	mock.ExpectQuery("SELECT host FROM lcd").
+		WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+
+	mock.ExpectExec("INSERT INTO validators_snapshots").
+		WithArgs("chain", sqlmock.AnyArg(), whaleAlertsNow).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestWhaleAlertsWatcherErrorSavingSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerWhaleAlertsValidators()
+
+	interacter := &StubInteracter{}
+	watcher, mock := getWhaleAlertsWatcher(t, interacter)
+
+	previous, _ := getPreviousValidatorsTokens(t)
+	previousBytes, err := json.Marshal(previous)
+	require.NoError(t, err)
+
+	mock.ExpectQuery("SELECT w.reporter, w.chat_id, w.chain, w.percent_threshold, w.tokens_threshold FROM whale_alerts").
+		WillReturnRows(sqlmock.NewRows(whaleAlertsColumns).AddRow("telegram", "1", "chain", 10, nil))
+
+	expectWhaleAlertsChains(mock)
+
+	mock.ExpectQuery("SELECT tokens, created_at FROM validators_snapshots").
+		WillReturnRows(sqlmock.NewRows([]string{"tokens", "created_at"}).
+			AddRow(previousBytes, whaleAlertsNow.Add(-5*time.Minute)))
+
+	mock.ExpectQuery("SELECT host FROM lcd").
+		WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, nil, false))
+
+	mock.ExpectExec("INSERT INTO validators_snapshots").
+		WillReturnError(errors.New("custom error"))
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, interacter.Notifications)
+}
+
+//nolint:paralleltest // disabled
+func TestWhaleAlertsWatcherOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerWhaleAlertsValidators()
+
+	interacter := &StubInteracter{}
+	watcher, mock := getWhaleAlertsWatcher(t, interacter)
+
+	previous, changed := getPreviousValidatorsTokens(t)
+	previousBytes, err := json.Marshal(previous)
+	require.NoError(t, err)
+
+	mock.ExpectQuery("SELECT w.reporter, w.chat_id, w.chain, w.percent_threshold, w.tokens_threshold FROM whale_alerts").
+		WillReturnRows(sqlmock.NewRows(whaleAlertsColumns).
+			AddRow("telegram", "1", "chain", 10, nil).
+			AddRow("telegram", "2", "chain", nil, 1000000))
+
+	expectWhaleAlertsChains(mock)
+
+	mock.ExpectQuery("SELECT tokens, created_at FROM validators_snapshots").
+		WillReturnRows(sqlmock.NewRows([]string{"tokens", "created_at"}).
+			AddRow(previousBytes, whaleAlertsNow.Add(-5*time.Minute)))
+
+	mock.ExpectQuery("SELECT host FROM lcd").
+		WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("https://example.com"))
+
+	mock.ExpectQuery("SELECT chain, denom, display_denom, denom_exponent, coingecko_currency, ignored FROM denoms").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"chain", "denom", "display_denom", "denom_exponent", "coingecko_currency", "ignored"}).
+			AddRow("chain", "uatom", "ATOM", 6, nil, false))
+
+	mock.ExpectExec("INSERT INTO validators_snapshots").
+		WithArgs("chain", sqlmock.AnyArg(), whaleAlertsNow).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	expectNotificationSettings(mock)
+
+	require.NoError(t, watcher.Tick())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	require.Len(t, interacter.Notifications, 1)
+	require.Len(t, interacter.Notifications["1"], 1)
+
+	event := interacter.Notifications["1"][0]
+	require.Equal(t, types.NotificationTypeWhale, event.Type)
+	require.Equal(t, "whale_alert", event.Template)
+
+	alert, ok := event.Data.(*types.WhaleAlert)
+	require.True(t, ok)
+	require.Equal(t, whaleAlertsNow.Add(-5*time.Minute), alert.Since)
+	require.Len(t, alert.Changes, 1)
+	require.Equal(t, changed, alert.Changes[0].OperatorAddress)
+	require.True(t, alert.Changes[0].IsIncrease())
+	require.Equal(t, "ATOM", alert.Changes[0].Change.Denom)
+}